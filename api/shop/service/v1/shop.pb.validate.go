@@ -899,3 +899,1451 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = DemoResponseValidationError{}
+
+// Validate checks the field values on ListBoxCampaignsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBoxCampaignsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBoxCampaignsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBoxCampaignsRequestMultiError, or nil if none found.
+func (m *ListBoxCampaignsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBoxCampaignsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListBoxCampaignsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBoxCampaignsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBoxCampaignsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBoxCampaignsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBoxCampaignsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBoxCampaignsRequestMultiError) AllErrors() []error { return m }
+
+// ListBoxCampaignsRequestValidationError is the validation error returned by
+// ListBoxCampaignsRequest.Validate if the designated constraints aren't met.
+type ListBoxCampaignsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBoxCampaignsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBoxCampaignsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBoxCampaignsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBoxCampaignsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBoxCampaignsRequestValidationError) ErrorName() string {
+	return "ListBoxCampaignsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBoxCampaignsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBoxCampaignsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBoxCampaignsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBoxCampaignsRequestValidationError{}
+
+// Validate checks the field values on ListBoxCampaignsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBoxCampaignsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBoxCampaignsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBoxCampaignsReplyMultiError, or nil if none found.
+func (m *ListBoxCampaignsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBoxCampaignsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCampaigns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBoxCampaignsReplyValidationError{
+						field:  fmt.Sprintf("Campaigns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBoxCampaignsReplyValidationError{
+						field:  fmt.Sprintf("Campaigns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBoxCampaignsReplyValidationError{
+					field:  fmt.Sprintf("Campaigns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListBoxCampaignsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBoxCampaignsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListBoxCampaignsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListBoxCampaignsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBoxCampaignsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBoxCampaignsReplyMultiError) AllErrors() []error { return m }
+
+// ListBoxCampaignsReplyValidationError is the validation error returned by
+// ListBoxCampaignsReply.Validate if the designated constraints aren't met.
+type ListBoxCampaignsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBoxCampaignsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBoxCampaignsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBoxCampaignsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBoxCampaignsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBoxCampaignsReplyValidationError) ErrorName() string {
+	return "ListBoxCampaignsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBoxCampaignsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBoxCampaignsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBoxCampaignsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBoxCampaignsReplyValidationError{}
+
+// Validate checks the field values on GetBoxCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBoxCampaignRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBoxCampaignRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBoxCampaignRequestMultiError, or nil if none found.
+func (m *GetBoxCampaignRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBoxCampaignRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetBoxCampaignRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBoxCampaignRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBoxCampaignRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBoxCampaignRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBoxCampaignRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBoxCampaignRequestMultiError) AllErrors() []error { return m }
+
+// GetBoxCampaignRequestValidationError is the validation error returned by
+// GetBoxCampaignRequest.Validate if the designated constraints aren't met.
+type GetBoxCampaignRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBoxCampaignRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBoxCampaignRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBoxCampaignRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBoxCampaignRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBoxCampaignRequestValidationError) ErrorName() string {
+	return "GetBoxCampaignRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBoxCampaignRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBoxCampaignRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBoxCampaignRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBoxCampaignRequestValidationError{}
+
+// Validate checks the field values on GetBoxCampaignReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBoxCampaignReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBoxCampaignReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBoxCampaignReplyMultiError, or nil if none found.
+func (m *GetBoxCampaignReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBoxCampaignReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCampaign()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBoxCampaignReplyValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBoxCampaignReplyValidationError{
+					field:  "Campaign",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCampaign()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBoxCampaignReplyValidationError{
+				field:  "Campaign",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetBoxCampaignReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBoxCampaignReplyMultiError is an error wrapping multiple validation
+// errors returned by GetBoxCampaignReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetBoxCampaignReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBoxCampaignReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBoxCampaignReplyMultiError) AllErrors() []error { return m }
+
+// GetBoxCampaignReplyValidationError is the validation error returned by
+// GetBoxCampaignReply.Validate if the designated constraints aren't met.
+type GetBoxCampaignReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBoxCampaignReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBoxCampaignReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBoxCampaignReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBoxCampaignReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBoxCampaignReplyValidationError) ErrorName() string {
+	return "GetBoxCampaignReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBoxCampaignReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBoxCampaignReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBoxCampaignReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBoxCampaignReplyValidationError{}
+
+// Validate checks the field values on OpenBoxRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OpenBoxRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenBoxRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OpenBoxRequestMultiError,
+// or nil if none found.
+func (m *OpenBoxRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenBoxRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CampaignId
+
+	// no validation rules for Count
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return OpenBoxRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenBoxRequestMultiError is an error wrapping multiple validation errors
+// returned by OpenBoxRequest.ValidateAll() if the designated constraints
+// aren't met.
+type OpenBoxRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenBoxRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenBoxRequestMultiError) AllErrors() []error { return m }
+
+// OpenBoxRequestValidationError is the validation error returned by
+// OpenBoxRequest.Validate if the designated constraints aren't met.
+type OpenBoxRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenBoxRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenBoxRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenBoxRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenBoxRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenBoxRequestValidationError) ErrorName() string { return "OpenBoxRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OpenBoxRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenBoxRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenBoxRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenBoxRequestValidationError{}
+
+// Validate checks the field values on OpenBoxReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OpenBoxReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenBoxReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OpenBoxReplyMultiError, or
+// nil if none found.
+func (m *OpenBoxReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenBoxReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	if all {
+		switch v := interface{}(m.GetAmount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OpenBoxReplyValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OpenBoxReplyValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAmount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OpenBoxReplyValidationError{
+				field:  "Amount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetDraws() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OpenBoxReplyValidationError{
+						field:  fmt.Sprintf("Draws[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OpenBoxReplyValidationError{
+						field:  fmt.Sprintf("Draws[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OpenBoxReplyValidationError{
+					field:  fmt.Sprintf("Draws[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Replayed
+
+	if len(errors) > 0 {
+		return OpenBoxReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenBoxReplyMultiError is an error wrapping multiple validation errors
+// returned by OpenBoxReply.ValidateAll() if the designated constraints aren't met.
+type OpenBoxReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenBoxReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenBoxReplyMultiError) AllErrors() []error { return m }
+
+// OpenBoxReplyValidationError is the validation error returned by
+// OpenBoxReply.Validate if the designated constraints aren't met.
+type OpenBoxReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenBoxReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenBoxReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenBoxReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenBoxReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenBoxReplyValidationError) ErrorName() string { return "OpenBoxReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OpenBoxReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenBoxReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenBoxReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenBoxReplyValidationError{}
+
+// Validate checks the field values on ListBoxDrawsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBoxDrawsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBoxDrawsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBoxDrawsRequestMultiError, or nil if none found.
+func (m *ListBoxDrawsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBoxDrawsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CampaignId
+
+	// no validation rules for Page
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListBoxDrawsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBoxDrawsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBoxDrawsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBoxDrawsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBoxDrawsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBoxDrawsRequestMultiError) AllErrors() []error { return m }
+
+// ListBoxDrawsRequestValidationError is the validation error returned by
+// ListBoxDrawsRequest.Validate if the designated constraints aren't met.
+type ListBoxDrawsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBoxDrawsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBoxDrawsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBoxDrawsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBoxDrawsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBoxDrawsRequestValidationError) ErrorName() string {
+	return "ListBoxDrawsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBoxDrawsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBoxDrawsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBoxDrawsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBoxDrawsRequestValidationError{}
+
+// Validate checks the field values on ListBoxDrawsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListBoxDrawsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBoxDrawsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBoxDrawsReplyMultiError, or nil if none found.
+func (m *ListBoxDrawsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBoxDrawsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDraws() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBoxDrawsReplyValidationError{
+						field:  fmt.Sprintf("Draws[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBoxDrawsReplyValidationError{
+						field:  fmt.Sprintf("Draws[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBoxDrawsReplyValidationError{
+					field:  fmt.Sprintf("Draws[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListBoxDrawsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBoxDrawsReplyMultiError is an error wrapping multiple validation errors
+// returned by ListBoxDrawsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListBoxDrawsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBoxDrawsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBoxDrawsReplyMultiError) AllErrors() []error { return m }
+
+// ListBoxDrawsReplyValidationError is the validation error returned by
+// ListBoxDrawsReply.Validate if the designated constraints aren't met.
+type ListBoxDrawsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBoxDrawsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBoxDrawsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBoxDrawsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBoxDrawsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBoxDrawsReplyValidationError) ErrorName() string {
+	return "ListBoxDrawsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBoxDrawsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBoxDrawsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBoxDrawsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBoxDrawsReplyValidationError{}
+
+// Validate checks the field values on GetBoxSeedRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBoxSeedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBoxSeedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBoxSeedRequestMultiError, or nil if none found.
+func (m *GetBoxSeedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBoxSeedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CampaignId
+
+	if len(errors) > 0 {
+		return GetBoxSeedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBoxSeedRequestMultiError is an error wrapping multiple validation errors
+// returned by GetBoxSeedRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetBoxSeedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBoxSeedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBoxSeedRequestMultiError) AllErrors() []error { return m }
+
+// GetBoxSeedRequestValidationError is the validation error returned by
+// GetBoxSeedRequest.Validate if the designated constraints aren't met.
+type GetBoxSeedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBoxSeedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBoxSeedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBoxSeedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBoxSeedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBoxSeedRequestValidationError) ErrorName() string {
+	return "GetBoxSeedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBoxSeedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBoxSeedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBoxSeedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBoxSeedRequestValidationError{}
+
+// Validate checks the field values on GetBoxSeedReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBoxSeedReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBoxSeedReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBoxSeedReplyMultiError, or nil if none found.
+func (m *GetBoxSeedReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBoxSeedReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Commitment
+
+	// no validation rules for ClientSeed
+
+	// no validation rules for Nonce
+
+	if len(errors) > 0 {
+		return GetBoxSeedReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBoxSeedReplyMultiError is an error wrapping multiple validation errors
+// returned by GetBoxSeedReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetBoxSeedReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBoxSeedReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBoxSeedReplyMultiError) AllErrors() []error { return m }
+
+// GetBoxSeedReplyValidationError is the validation error returned by
+// GetBoxSeedReply.Validate if the designated constraints aren't met.
+type GetBoxSeedReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBoxSeedReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBoxSeedReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBoxSeedReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBoxSeedReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBoxSeedReplyValidationError) ErrorName() string { return "GetBoxSeedReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetBoxSeedReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBoxSeedReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBoxSeedReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBoxSeedReplyValidationError{}
+
+// Validate checks the field values on RotateBoxSeedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateBoxSeedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateBoxSeedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RotateBoxSeedRequestMultiError, or nil if none found.
+func (m *RotateBoxSeedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateBoxSeedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CampaignId
+
+	// no validation rules for ClientSeed
+
+	if len(errors) > 0 {
+		return RotateBoxSeedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RotateBoxSeedRequestMultiError is an error wrapping multiple validation
+// errors returned by RotateBoxSeedRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RotateBoxSeedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateBoxSeedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateBoxSeedRequestMultiError) AllErrors() []error { return m }
+
+// RotateBoxSeedRequestValidationError is the validation error returned by
+// RotateBoxSeedRequest.Validate if the designated constraints aren't met.
+type RotateBoxSeedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateBoxSeedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateBoxSeedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateBoxSeedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateBoxSeedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateBoxSeedRequestValidationError) ErrorName() string {
+	return "RotateBoxSeedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateBoxSeedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateBoxSeedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateBoxSeedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateBoxSeedRequestValidationError{}
+
+// Validate checks the field values on RotateBoxSeedReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateBoxSeedReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateBoxSeedReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RotateBoxSeedReplyMultiError, or nil if none found.
+func (m *RotateBoxSeedReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateBoxSeedReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServerSeed
+
+	// no validation rules for Commitment
+
+	// no validation rules for ClientSeed
+
+	// no validation rules for Nonce
+
+	if all {
+		switch v := interface{}(m.GetNext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RotateBoxSeedReplyValidationError{
+					field:  "Next",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RotateBoxSeedReplyValidationError{
+					field:  "Next",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RotateBoxSeedReplyValidationError{
+				field:  "Next",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RotateBoxSeedReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RotateBoxSeedReplyMultiError is an error wrapping multiple validation errors
+// returned by RotateBoxSeedReply.ValidateAll() if the designated constraints
+// aren't met.
+type RotateBoxSeedReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateBoxSeedReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateBoxSeedReplyMultiError) AllErrors() []error { return m }
+
+// RotateBoxSeedReplyValidationError is the validation error returned by
+// RotateBoxSeedReply.Validate if the designated constraints aren't met.
+type RotateBoxSeedReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateBoxSeedReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateBoxSeedReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateBoxSeedReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateBoxSeedReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateBoxSeedReplyValidationError) ErrorName() string {
+	return "RotateBoxSeedReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateBoxSeedReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateBoxSeedReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateBoxSeedReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateBoxSeedReplyValidationError{}
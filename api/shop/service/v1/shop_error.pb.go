@@ -32,16 +32,28 @@ const (
 	ShopServiceErrorReason_SHOP_DUPLICATE_ENTRY  ShopServiceErrorReason = 2
 	ShopServiceErrorReason_SHOP_PERMITION_DENIED ShopServiceErrorReason = 3
 	ShopServiceErrorReason_SHOP_INVALID_TOKEN    ShopServiceErrorReason = 4
+	ShopServiceErrorReason_SHOP_INVALID_PARAMS   ShopServiceErrorReason = 5
+	ShopServiceErrorReason_SHOP_LOGIN_FAILED     ShopServiceErrorReason = 6
+	ShopServiceErrorReason_SHOP_INTERNAL_ERROR   ShopServiceErrorReason = 7 // 未声明映射的下游错误统一返回
+	ShopServiceErrorReason_SHOP_BOX_UNAVAILABLE  ShopServiceErrorReason = 8 // 盲盒活动未开始、已暂停或已结束
+	ShopServiceErrorReason_SHOP_BOX_SOLD_OUT     ShopServiceErrorReason = 9
+	ShopServiceErrorReason_SHOP_BOX_TOO_MANY     ShopServiceErrorReason = 10 // 超过单次开盒上限，metadata.max 为上限
 )
 
 // Enum value maps for ShopServiceErrorReason.
 var (
 	ShopServiceErrorReason_name = map[int32]string{
-		0: "SHOP_RECORD_NOT_FOUND",
-		1: "SHOP_CONTENT_MISSING",
-		2: "SHOP_DUPLICATE_ENTRY",
-		3: "SHOP_PERMITION_DENIED",
-		4: "SHOP_INVALID_TOKEN",
+		0:  "SHOP_RECORD_NOT_FOUND",
+		1:  "SHOP_CONTENT_MISSING",
+		2:  "SHOP_DUPLICATE_ENTRY",
+		3:  "SHOP_PERMITION_DENIED",
+		4:  "SHOP_INVALID_TOKEN",
+		5:  "SHOP_INVALID_PARAMS",
+		6:  "SHOP_LOGIN_FAILED",
+		7:  "SHOP_INTERNAL_ERROR",
+		8:  "SHOP_BOX_UNAVAILABLE",
+		9:  "SHOP_BOX_SOLD_OUT",
+		10: "SHOP_BOX_TOO_MANY",
 	}
 	ShopServiceErrorReason_value = map[string]int32{
 		"SHOP_RECORD_NOT_FOUND": 0,
@@ -49,6 +61,12 @@ var (
 		"SHOP_DUPLICATE_ENTRY":  2,
 		"SHOP_PERMITION_DENIED": 3,
 		"SHOP_INVALID_TOKEN":    4,
+		"SHOP_INVALID_PARAMS":   5,
+		"SHOP_LOGIN_FAILED":     6,
+		"SHOP_INTERNAL_ERROR":   7,
+		"SHOP_BOX_UNAVAILABLE":  8,
+		"SHOP_BOX_SOLD_OUT":     9,
+		"SHOP_BOX_TOO_MANY":     10,
 	}
 )
 
@@ -87,7 +105,7 @@ var file_api_shop_service_v1_shop_error_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x68, 0x6f, 0x70,
 	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x13, 0x65, 0x72, 0x72,
 	0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2a, 0xbe, 0x01, 0x0a, 0x16, 0x53, 0x68, 0x6f, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2a, 0xf3, 0x02, 0x0a, 0x16, 0x53, 0x68, 0x6f, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x15, 0x53,
 	0x48, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
 	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x00, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x1e, 0x0a, 0x14,
@@ -98,10 +116,21 @@ var file_api_shop_service_v1_shop_error_proto_rawDesc = []byte{
 	0x53, 0x48, 0x4f, 0x50, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44,
 	0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03, 0x12, 0x1c, 0x0a,
 	0x12, 0x53, 0x48, 0x4f, 0x50, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x4f,
-	0x4b, 0x45, 0x4e, 0x10, 0x04, 0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03, 0x1a, 0x04, 0xa0, 0x45, 0xf4,
-	0x03, 0x42, 0x1e, 0x5a, 0x1c, 0x63, 0x61, 0x73, 0x73, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73,
-	0x68, 0x6f, 0x70, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4b, 0x45, 0x4e, 0x10, 0x04, 0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03, 0x12, 0x1d, 0x0a, 0x13, 0x53,
+	0x48, 0x4f, 0x50, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x41,
+	0x4d, 0x53, 0x10, 0x05, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1b, 0x0a, 0x11, 0x53, 0x48,
+	0x4f, 0x50, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x06, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1d, 0x0a, 0x13, 0x53, 0x48, 0x4f, 0x50, 0x5f,
+	0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x07,
+	0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1e, 0x0a, 0x14, 0x53, 0x48, 0x4f, 0x50, 0x5f, 0x42,
+	0x4f, 0x58, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x08,
+	0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1b, 0x0a, 0x11, 0x53, 0x48, 0x4f, 0x50, 0x5f, 0x42,
+	0x4f, 0x58, 0x5f, 0x53, 0x4f, 0x4c, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x09, 0x1a, 0x04, 0xa8,
+	0x45, 0x90, 0x03, 0x12, 0x1b, 0x0a, 0x11, 0x53, 0x48, 0x4f, 0x50, 0x5f, 0x42, 0x4f, 0x58, 0x5f,
+	0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x10, 0x0a, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03,
+	0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x1e, 0x5a, 0x1c, 0x63, 0x61, 0x73, 0x73, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x73, 0x68, 0x6f, 0x70, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -70,3 +70,75 @@ func IsShopInvalidToken(err error) bool {
 func ErrorShopInvalidToken(format string, args ...interface{}) *errors.Error {
 	return errors.New(401, ShopServiceErrorReason_SHOP_INVALID_TOKEN.String(), fmt.Sprintf(format, args...))
 }
+
+func IsShopInvalidParams(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ShopServiceErrorReason_SHOP_INVALID_PARAMS.String() && e.Code == 400
+}
+
+func ErrorShopInvalidParams(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ShopServiceErrorReason_SHOP_INVALID_PARAMS.String(), fmt.Sprintf(format, args...))
+}
+
+func IsShopLoginFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ShopServiceErrorReason_SHOP_LOGIN_FAILED.String() && e.Code == 400
+}
+
+func ErrorShopLoginFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ShopServiceErrorReason_SHOP_LOGIN_FAILED.String(), fmt.Sprintf(format, args...))
+}
+
+func IsShopInternalError(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ShopServiceErrorReason_SHOP_INTERNAL_ERROR.String() && e.Code == 500
+}
+
+func ErrorShopInternalError(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ShopServiceErrorReason_SHOP_INTERNAL_ERROR.String(), fmt.Sprintf(format, args...))
+}
+
+func IsShopBoxUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ShopServiceErrorReason_SHOP_BOX_UNAVAILABLE.String() && e.Code == 400
+}
+
+func ErrorShopBoxUnavailable(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ShopServiceErrorReason_SHOP_BOX_UNAVAILABLE.String(), fmt.Sprintf(format, args...))
+}
+
+func IsShopBoxSoldOut(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ShopServiceErrorReason_SHOP_BOX_SOLD_OUT.String() && e.Code == 400
+}
+
+func ErrorShopBoxSoldOut(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ShopServiceErrorReason_SHOP_BOX_SOLD_OUT.String(), fmt.Sprintf(format, args...))
+}
+
+func IsShopBoxTooMany(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ShopServiceErrorReason_SHOP_BOX_TOO_MANY.String() && e.Code == 400
+}
+
+func ErrorShopBoxTooMany(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ShopServiceErrorReason_SHOP_BOX_TOO_MANY.String(), fmt.Sprintf(format, args...))
+}
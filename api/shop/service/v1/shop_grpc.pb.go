@@ -27,6 +27,13 @@ type ShopClient interface {
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginReply, error)
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserReply, error)
 	Demo(ctx context.Context, in *DemoRequest, opts ...grpc.CallOption) (*DemoResponse, error)
+	// 盲盒：活动列表与详情无需登录，开盒、记录与种子需要登录
+	ListBoxCampaigns(ctx context.Context, in *ListBoxCampaignsRequest, opts ...grpc.CallOption) (*ListBoxCampaignsReply, error)
+	GetBoxCampaign(ctx context.Context, in *GetBoxCampaignRequest, opts ...grpc.CallOption) (*GetBoxCampaignReply, error)
+	OpenBox(ctx context.Context, in *OpenBoxRequest, opts ...grpc.CallOption) (*OpenBoxReply, error)
+	ListBoxDraws(ctx context.Context, in *ListBoxDrawsRequest, opts ...grpc.CallOption) (*ListBoxDrawsReply, error)
+	GetBoxSeed(ctx context.Context, in *GetBoxSeedRequest, opts ...grpc.CallOption) (*GetBoxSeedReply, error)
+	RotateBoxSeed(ctx context.Context, in *RotateBoxSeedRequest, opts ...grpc.CallOption) (*RotateBoxSeedReply, error)
 }
 
 type shopClient struct {
@@ -73,6 +80,60 @@ func (c *shopClient) Demo(ctx context.Context, in *DemoRequest, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *shopClient) ListBoxCampaigns(ctx context.Context, in *ListBoxCampaignsRequest, opts ...grpc.CallOption) (*ListBoxCampaignsReply, error) {
+	out := new(ListBoxCampaignsReply)
+	err := c.cc.Invoke(ctx, "/api.shop.service.v1.Shop/ListBoxCampaigns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) GetBoxCampaign(ctx context.Context, in *GetBoxCampaignRequest, opts ...grpc.CallOption) (*GetBoxCampaignReply, error) {
+	out := new(GetBoxCampaignReply)
+	err := c.cc.Invoke(ctx, "/api.shop.service.v1.Shop/GetBoxCampaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) OpenBox(ctx context.Context, in *OpenBoxRequest, opts ...grpc.CallOption) (*OpenBoxReply, error) {
+	out := new(OpenBoxReply)
+	err := c.cc.Invoke(ctx, "/api.shop.service.v1.Shop/OpenBox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) ListBoxDraws(ctx context.Context, in *ListBoxDrawsRequest, opts ...grpc.CallOption) (*ListBoxDrawsReply, error) {
+	out := new(ListBoxDrawsReply)
+	err := c.cc.Invoke(ctx, "/api.shop.service.v1.Shop/ListBoxDraws", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) GetBoxSeed(ctx context.Context, in *GetBoxSeedRequest, opts ...grpc.CallOption) (*GetBoxSeedReply, error) {
+	out := new(GetBoxSeedReply)
+	err := c.cc.Invoke(ctx, "/api.shop.service.v1.Shop/GetBoxSeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shopClient) RotateBoxSeed(ctx context.Context, in *RotateBoxSeedRequest, opts ...grpc.CallOption) (*RotateBoxSeedReply, error) {
+	out := new(RotateBoxSeedReply)
+	err := c.cc.Invoke(ctx, "/api.shop.service.v1.Shop/RotateBoxSeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ShopServer is the server API for Shop service.
 // All implementations must embed UnimplementedShopServer
 // for forward compatibility
@@ -82,6 +143,13 @@ type ShopServer interface {
 	Login(context.Context, *LoginRequest) (*LoginReply, error)
 	GetUser(context.Context, *GetUserRequest) (*GetUserReply, error)
 	Demo(context.Context, *DemoRequest) (*DemoResponse, error)
+	// 盲盒：活动列表与详情无需登录，开盒、记录与种子需要登录
+	ListBoxCampaigns(context.Context, *ListBoxCampaignsRequest) (*ListBoxCampaignsReply, error)
+	GetBoxCampaign(context.Context, *GetBoxCampaignRequest) (*GetBoxCampaignReply, error)
+	OpenBox(context.Context, *OpenBoxRequest) (*OpenBoxReply, error)
+	ListBoxDraws(context.Context, *ListBoxDrawsRequest) (*ListBoxDrawsReply, error)
+	GetBoxSeed(context.Context, *GetBoxSeedRequest) (*GetBoxSeedReply, error)
+	RotateBoxSeed(context.Context, *RotateBoxSeedRequest) (*RotateBoxSeedReply, error)
 	mustEmbedUnimplementedShopServer()
 }
 
@@ -101,6 +169,24 @@ func (UnimplementedShopServer) GetUser(context.Context, *GetUserRequest) (*GetUs
 func (UnimplementedShopServer) Demo(context.Context, *DemoRequest) (*DemoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Demo not implemented")
 }
+func (UnimplementedShopServer) ListBoxCampaigns(context.Context, *ListBoxCampaignsRequest) (*ListBoxCampaignsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBoxCampaigns not implemented")
+}
+func (UnimplementedShopServer) GetBoxCampaign(context.Context, *GetBoxCampaignRequest) (*GetBoxCampaignReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoxCampaign not implemented")
+}
+func (UnimplementedShopServer) OpenBox(context.Context, *OpenBoxRequest) (*OpenBoxReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenBox not implemented")
+}
+func (UnimplementedShopServer) ListBoxDraws(context.Context, *ListBoxDrawsRequest) (*ListBoxDrawsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBoxDraws not implemented")
+}
+func (UnimplementedShopServer) GetBoxSeed(context.Context, *GetBoxSeedRequest) (*GetBoxSeedReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoxSeed not implemented")
+}
+func (UnimplementedShopServer) RotateBoxSeed(context.Context, *RotateBoxSeedRequest) (*RotateBoxSeedReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateBoxSeed not implemented")
+}
 func (UnimplementedShopServer) mustEmbedUnimplementedShopServer() {}
 
 // UnsafeShopServer may be embedded to opt out of forward compatibility for this service.
@@ -186,6 +272,114 @@ func _Shop_Demo_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Shop_ListBoxCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBoxCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServer).ListBoxCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.shop.service.v1.Shop/ListBoxCampaigns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).ListBoxCampaigns(ctx, req.(*ListBoxCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shop_GetBoxCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBoxCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServer).GetBoxCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.shop.service.v1.Shop/GetBoxCampaign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).GetBoxCampaign(ctx, req.(*GetBoxCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shop_OpenBox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenBoxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServer).OpenBox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.shop.service.v1.Shop/OpenBox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).OpenBox(ctx, req.(*OpenBoxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shop_ListBoxDraws_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBoxDrawsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServer).ListBoxDraws(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.shop.service.v1.Shop/ListBoxDraws",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).ListBoxDraws(ctx, req.(*ListBoxDrawsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shop_GetBoxSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBoxSeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServer).GetBoxSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.shop.service.v1.Shop/GetBoxSeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).GetBoxSeed(ctx, req.(*GetBoxSeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shop_RotateBoxSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateBoxSeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShopServer).RotateBoxSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.shop.service.v1.Shop/RotateBoxSeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShopServer).RotateBoxSeed(ctx, req.(*RotateBoxSeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Shop_ServiceDesc is the grpc.ServiceDesc for Shop service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -209,6 +403,30 @@ var Shop_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Demo",
 			Handler:    _Shop_Demo_Handler,
 		},
+		{
+			MethodName: "ListBoxCampaigns",
+			Handler:    _Shop_ListBoxCampaigns_Handler,
+		},
+		{
+			MethodName: "GetBoxCampaign",
+			Handler:    _Shop_GetBoxCampaign_Handler,
+		},
+		{
+			MethodName: "OpenBox",
+			Handler:    _Shop_OpenBox_Handler,
+		},
+		{
+			MethodName: "ListBoxDraws",
+			Handler:    _Shop_ListBoxDraws_Handler,
+		},
+		{
+			MethodName: "GetBoxSeed",
+			Handler:    _Shop_GetBoxSeed_Handler,
+		},
+		{
+			MethodName: "RotateBoxSeed",
+			Handler:    _Shop_RotateBoxSeed_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/shop/service/v1/shop.proto",
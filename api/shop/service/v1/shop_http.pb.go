@@ -19,9 +19,15 @@ const _ = http.SupportPackageIsVersion1
 
 type ShopHTTPServer interface {
 	Demo(context.Context, *DemoRequest) (*DemoResponse, error)
+	GetBoxCampaign(context.Context, *GetBoxCampaignRequest) (*GetBoxCampaignReply, error)
+	GetBoxSeed(context.Context, *GetBoxSeedRequest) (*GetBoxSeedReply, error)
 	GetUser(context.Context, *GetUserRequest) (*GetUserReply, error)
+	ListBoxCampaigns(context.Context, *ListBoxCampaignsRequest) (*ListBoxCampaignsReply, error)
+	ListBoxDraws(context.Context, *ListBoxDrawsRequest) (*ListBoxDrawsReply, error)
 	Login(context.Context, *LoginRequest) (*LoginReply, error)
+	OpenBox(context.Context, *OpenBoxRequest) (*OpenBoxReply, error)
 	Register(context.Context, *RegisterRequest) (*RegisterReply, error)
+	RotateBoxSeed(context.Context, *RotateBoxSeedRequest) (*RotateBoxSeedReply, error)
 }
 
 func RegisterShopHTTPServer(s *http.Server, srv ShopHTTPServer) {
@@ -30,6 +36,12 @@ func RegisterShopHTTPServer(s *http.Server, srv ShopHTTPServer) {
 	r.POST("/v1/login", _Shop_Login0_HTTP_Handler(srv))
 	r.GET("/v1/me", _Shop_GetUser0_HTTP_Handler(srv))
 	r.PUT("/v1/me/{id}", _Shop_Demo0_HTTP_Handler(srv))
+	r.GET("/v1/boxes", _Shop_ListBoxCampaigns0_HTTP_Handler(srv))
+	r.GET("/v1/boxes/{id}", _Shop_GetBoxCampaign0_HTTP_Handler(srv))
+	r.POST("/v1/boxes/{campaign_id}/open", _Shop_OpenBox0_HTTP_Handler(srv))
+	r.GET("/v1/me/box-draws", _Shop_ListBoxDraws0_HTTP_Handler(srv))
+	r.GET("/v1/boxes/{campaign_id}/seed", _Shop_GetBoxSeed0_HTTP_Handler(srv))
+	r.POST("/v1/boxes/{campaign_id}/seed/rotate", _Shop_RotateBoxSeed0_HTTP_Handler(srv))
 }
 
 func _Shop_Register0_HTTP_Handler(srv ShopHTTPServer) func(ctx http.Context) error {
@@ -114,11 +126,143 @@ func _Shop_Demo0_HTTP_Handler(srv ShopHTTPServer) func(ctx http.Context) error {
 	}
 }
 
+func _Shop_ListBoxCampaigns0_HTTP_Handler(srv ShopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListBoxCampaignsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.shop.service.v1.Shop/ListBoxCampaigns")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListBoxCampaigns(ctx, req.(*ListBoxCampaignsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListBoxCampaignsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Shop_GetBoxCampaign0_HTTP_Handler(srv ShopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetBoxCampaignRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.shop.service.v1.Shop/GetBoxCampaign")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetBoxCampaign(ctx, req.(*GetBoxCampaignRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetBoxCampaignReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Shop_OpenBox0_HTTP_Handler(srv ShopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in OpenBoxRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.shop.service.v1.Shop/OpenBox")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.OpenBox(ctx, req.(*OpenBoxRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OpenBoxReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Shop_ListBoxDraws0_HTTP_Handler(srv ShopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListBoxDrawsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.shop.service.v1.Shop/ListBoxDraws")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListBoxDraws(ctx, req.(*ListBoxDrawsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListBoxDrawsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Shop_GetBoxSeed0_HTTP_Handler(srv ShopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetBoxSeedRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.shop.service.v1.Shop/GetBoxSeed")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetBoxSeed(ctx, req.(*GetBoxSeedRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetBoxSeedReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Shop_RotateBoxSeed0_HTTP_Handler(srv ShopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RotateBoxSeedRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, "/api.shop.service.v1.Shop/RotateBoxSeed")
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RotateBoxSeed(ctx, req.(*RotateBoxSeedRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RotateBoxSeedReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ShopHTTPClient interface {
 	Demo(ctx context.Context, req *DemoRequest, opts ...http.CallOption) (rsp *DemoResponse, err error)
+	GetBoxCampaign(ctx context.Context, req *GetBoxCampaignRequest, opts ...http.CallOption) (rsp *GetBoxCampaignReply, err error)
+	GetBoxSeed(ctx context.Context, req *GetBoxSeedRequest, opts ...http.CallOption) (rsp *GetBoxSeedReply, err error)
 	GetUser(ctx context.Context, req *GetUserRequest, opts ...http.CallOption) (rsp *GetUserReply, err error)
+	ListBoxCampaigns(ctx context.Context, req *ListBoxCampaignsRequest, opts ...http.CallOption) (rsp *ListBoxCampaignsReply, err error)
+	ListBoxDraws(ctx context.Context, req *ListBoxDrawsRequest, opts ...http.CallOption) (rsp *ListBoxDrawsReply, err error)
 	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	OpenBox(ctx context.Context, req *OpenBoxRequest, opts ...http.CallOption) (rsp *OpenBoxReply, err error)
 	Register(ctx context.Context, req *RegisterRequest, opts ...http.CallOption) (rsp *RegisterReply, err error)
+	RotateBoxSeed(ctx context.Context, req *RotateBoxSeedRequest, opts ...http.CallOption) (rsp *RotateBoxSeedReply, err error)
 }
 
 type ShopHTTPClientImpl struct {
@@ -142,6 +286,32 @@ func (c *ShopHTTPClientImpl) Demo(ctx context.Context, in *DemoRequest, opts ...
 	return &out, err
 }
 
+func (c *ShopHTTPClientImpl) GetBoxCampaign(ctx context.Context, in *GetBoxCampaignRequest, opts ...http.CallOption) (*GetBoxCampaignReply, error) {
+	var out GetBoxCampaignReply
+	pattern := "/v1/boxes/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/api.shop.service.v1.Shop/GetBoxCampaign"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ShopHTTPClientImpl) GetBoxSeed(ctx context.Context, in *GetBoxSeedRequest, opts ...http.CallOption) (*GetBoxSeedReply, error) {
+	var out GetBoxSeedReply
+	pattern := "/v1/boxes/{campaign_id}/seed"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/api.shop.service.v1.Shop/GetBoxSeed"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ShopHTTPClientImpl) GetUser(ctx context.Context, in *GetUserRequest, opts ...http.CallOption) (*GetUserReply, error) {
 	var out GetUserReply
 	pattern := "/v1/me"
@@ -155,6 +325,32 @@ func (c *ShopHTTPClientImpl) GetUser(ctx context.Context, in *GetUserRequest, op
 	return &out, err
 }
 
+func (c *ShopHTTPClientImpl) ListBoxCampaigns(ctx context.Context, in *ListBoxCampaignsRequest, opts ...http.CallOption) (*ListBoxCampaignsReply, error) {
+	var out ListBoxCampaignsReply
+	pattern := "/v1/boxes"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/api.shop.service.v1.Shop/ListBoxCampaigns"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ShopHTTPClientImpl) ListBoxDraws(ctx context.Context, in *ListBoxDrawsRequest, opts ...http.CallOption) (*ListBoxDrawsReply, error) {
+	var out ListBoxDrawsReply
+	pattern := "/v1/me/box-draws"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation("/api.shop.service.v1.Shop/ListBoxDraws"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ShopHTTPClientImpl) Login(ctx context.Context, in *LoginRequest, opts ...http.CallOption) (*LoginReply, error) {
 	var out LoginReply
 	pattern := "/v1/login"
@@ -168,6 +364,19 @@ func (c *ShopHTTPClientImpl) Login(ctx context.Context, in *LoginRequest, opts .
 	return &out, err
 }
 
+func (c *ShopHTTPClientImpl) OpenBox(ctx context.Context, in *OpenBoxRequest, opts ...http.CallOption) (*OpenBoxReply, error) {
+	var out OpenBoxReply
+	pattern := "/v1/boxes/{campaign_id}/open"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/api.shop.service.v1.Shop/OpenBox"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ShopHTTPClientImpl) Register(ctx context.Context, in *RegisterRequest, opts ...http.CallOption) (*RegisterReply, error) {
 	var out RegisterReply
 	pattern := "/v1/register"
@@ -180,3 +389,16 @@ func (c *ShopHTTPClientImpl) Register(ctx context.Context, in *RegisterRequest,
 	}
 	return &out, err
 }
+
+func (c *ShopHTTPClientImpl) RotateBoxSeed(ctx context.Context, in *RotateBoxSeedRequest, opts ...http.CallOption) (*RotateBoxSeedReply, error) {
+	var out RotateBoxSeedReply
+	pattern := "/v1/boxes/{campaign_id}/seed/rotate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation("/api.shop.service.v1.Shop/RotateBoxSeed"))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
@@ -32,6 +32,7 @@ const (
 	UserServiceErrorReason_USER_INVALID_PASS     UserServiceErrorReason = 2
 	UserServiceErrorReason_USER_CONTENT_MISSING  UserServiceErrorReason = 3
 	UserServiceErrorReason_USER_MAKE_TOKEN_ERROR UserServiceErrorReason = 4
+	UserServiceErrorReason_USER_DUPLICATE_ENTRY  UserServiceErrorReason = 5 // metadata.field 为重复的字段
 )
 
 // Enum value maps for UserServiceErrorReason.
@@ -42,6 +43,7 @@ var (
 		2: "USER_INVALID_PASS",
 		3: "USER_CONTENT_MISSING",
 		4: "USER_MAKE_TOKEN_ERROR",
+		5: "USER_DUPLICATE_ENTRY",
 	}
 	UserServiceErrorReason_value = map[string]int32{
 		"USER_INVALID_PARAMS":   0,
@@ -49,6 +51,7 @@ var (
 		"USER_INVALID_PASS":     2,
 		"USER_CONTENT_MISSING":  3,
 		"USER_MAKE_TOKEN_ERROR": 4,
+		"USER_DUPLICATE_ENTRY":  5,
 	}
 )
 
@@ -87,7 +90,7 @@ var file_api_user_service_v1_user_error_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72,
 	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x13, 0x65, 0x72, 0x72,
 	0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2a, 0xbc, 0x01, 0x0a, 0x16, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2a, 0xdc, 0x01, 0x0a, 0x16, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x13, 0x55,
 	0x53, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x41,
 	0x4d, 0x53, 0x10, 0x00, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1f, 0x0a, 0x15, 0x55, 0x53,
@@ -98,7 +101,9 @@ var file_api_user_service_v1_user_error_proto_rawDesc = []byte{
 	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47,
 	0x10, 0x03, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1f, 0x0a, 0x15, 0x55, 0x53, 0x45, 0x52,
 	0x5f, 0x4d, 0x41, 0x4b, 0x45, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f,
-	0x52, 0x10, 0x04, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42,
+	0x52, 0x10, 0x04, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1e, 0x0a, 0x14, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x4e, 0x54, 0x52,
+	0x59, 0x10, 0x05, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42,
 	0x1e, 0x5a, 0x1c, 0x63, 0x61, 0x73, 0x73, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x75, 0x73, 0x65,
 	0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62,
 	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
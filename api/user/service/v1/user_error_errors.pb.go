@@ -70,3 +70,15 @@ func IsUserMakeTokenError(err error) bool {
 func ErrorUserMakeTokenError(format string, args ...interface{}) *errors.Error {
 	return errors.New(500, UserServiceErrorReason_USER_MAKE_TOKEN_ERROR.String(), fmt.Sprintf(format, args...))
 }
+
+func IsUserDuplicateEntry(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserServiceErrorReason_USER_DUPLICATE_ENTRY.String() && e.Code == 400
+}
+
+func ErrorUserDuplicateEntry(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, UserServiceErrorReason_USER_DUPLICATE_ENTRY.String(), fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"casso/app/box/service/internal/conf"
+	"casso/app/box/service/internal/data/migrations"
+	"casso/pkg/config"
+	"casso/pkg/migrate"
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"os"
+
+	// init mysql driver
+	_ "github.com/go-sql-driver/mysql"
+)
+
+// 数据库迁移: go run ./cmd/migrate -conf ../../configs [-dry-run] [-y] up|down|status [N]
+var (
+	// Name 同一个库的不同服务使用各自的迁移锁
+	Name = "casso.box.service"
+
+	flagconf string
+	dryRun   bool
+	yes      bool
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.BoolVar(&dryRun, "dry-run", false, "print SQL without executing")
+	flag.BoolVar(&yes, "y", false, "apply without confirmation")
+}
+
+func main() {
+	flag.Parse()
+
+	c, err := config.Open(flagconf)
+	if err != nil {
+		panic(err)
+	}
+	defer c.Close()
+
+	bc, err := config.Load[conf.Bootstrap](c, "")
+	if err != nil {
+		panic(err)
+	}
+
+	db, err := sql.Open(bc.Data.Database.Driver, bc.Data.Database.Source)
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close()
+
+	ms, err := migrate.Load(migrations.FS, ".")
+	if err != nil {
+		panic(err)
+	}
+
+	cli := &migrate.CLI{
+		DB:         db,
+		Migrations: ms,
+		LockName:   Name + ".migrate",
+		DryRun:     dryRun,
+		Yes:        yes,
+		In:         os.Stdin,
+		Out:        os.Stdout,
+	}
+	if err := cli.Run(context.Background(), flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
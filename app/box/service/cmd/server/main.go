@@ -0,0 +1,102 @@
+package main
+
+import (
+	"casso/app/box/service/internal/conf"
+	"casso/pkg/balancer"
+	"casso/pkg/config"
+	"casso/pkg/health"
+	"casso/pkg/logging"
+	"casso/pkg/metrics"
+	"casso/pkg/tracing"
+	"flag"
+
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// go build -ldflags "-X main.Version=x.y.z"
+var (
+	// Name is the name of the compiled software.
+	Name = "casso.box.service"
+	// Version is the version of the compiled software.
+	Version = "v1"
+	// flagconf is the config flag.
+	flagconf string
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+}
+
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, rr registry.Registrar, h *health.Health) *kratos.App {
+	return kratos.New(
+		kratos.Name(Name),
+		kratos.Version(Version),
+		kratos.Metadata(balancer.Metadata(Version)), // 版本、可用区、权重，用于负载均衡与灰度
+		kratos.Logger(logger),
+		kratos.Server(
+			gs,
+			hs,
+			h.Server(rr), // 定时检查依赖，未就绪时从注册中心注销
+		),
+		kratos.Registrar(rr),
+	)
+}
+
+func main() {
+	flag.Parse() // 获取终端命令
+	// 环境变量 CASSO_ 覆盖配置文件，配置加载时校验，非法的修改不生效
+	c, err := config.Open(flagconf)
+	if err != nil {
+		panic(err)
+	}
+	defer c.Close()
+
+	bc, err := config.Load[conf.Bootstrap](c, "") // config.yaml
+	if err != nil {
+		panic(err)
+	}
+
+	// JSON 日志，级别随配置 log.level 热更新
+	zl, err := logging.New(bc.Log)
+	if err != nil {
+		panic(err)
+	}
+	defer zl.Close()
+	logger := logging.With(zl, Name, Version)
+	c.SetLogger(logger)
+	if _, err := config.Watch(c, "log", func(_, new *logging.Config) {
+		_ = zl.SetLevel(new.GetLevel())
+	}); err != nil {
+		panic(err)
+	}
+
+	rc, err := config.Load[conf.Registry](c, "") // registry.yaml
+	if err != nil {
+		panic(err)
+	}
+
+	// 按配置上报到 OTLP collector，跨服务通过 W3C traceparent 传递
+	tp, cleanupTrace, err := tracing.NewTracerProvider(bc.Trace, Name, Version)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanupTrace()
+
+	// 全部指标带有服务名与版本标签，由管理端口的 /metrics 暴露
+	mp := metrics.NewPrometheus(Name, Version)
+
+	app, cleanup, err := initApp(bc.Server, rc, bc.Data, bc.Box, logger, tp, mp)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	// start and wait for stop signal
+	if err := app.Run(); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,24 @@
+// +build wireinject
+
+// The build tag makes sure the stub is not built in the final build.
+
+package main
+
+import (
+	"casso/app/box/service/internal/biz"
+	"casso/app/box/service/internal/conf"
+	"casso/app/box/service/internal/data"
+	"casso/app/box/service/internal/server"
+	"casso/app/box/service/internal/service"
+	"casso/pkg/metrics"
+
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// initApp init kratos application.
+func initApp(*conf.Server, *conf.Registry, *conf.Data, *conf.Box, log.Logger, *tracesdk.TracerProvider, *metrics.Prometheus) (*kratos.App, func(), error) {
+	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, newApp))
+}
@@ -0,0 +1,148 @@
+package biz
+
+import (
+	pb "casso/api/box/service/v1"
+	commonv1 "casso/api/common/v1"
+	"casso/app/box/service/internal/model"
+	"casso/pkg/money"
+	"casso/pkg/util/mystery"
+	"context"
+)
+
+// ********* 管理接口：活动配置与奖池 ***********
+
+func (uc *BoxUseCase) CreateCampaign(ctx context.Context, req *pb.CreateCampaignRequest) (*pb.CreateCampaignReply, error) {
+	c := &model.Campaign{Status: model.CampaignDraft}
+	if err := uc.fillCampaign(c, req.Name, req.Description, req.Price, toMilli(req.StartTime), toMilli(req.EndTime), req.MaxOpen); err != nil {
+		return &pb.CreateCampaignReply{}, err
+	}
+	res, err := uc.campaigns.Create(ctx, c)
+	if err != nil {
+		return &pb.CreateCampaignReply{}, err
+	}
+	return &pb.CreateCampaignReply{Campaign: campaignToProto(res, nil, nil)}, nil
+}
+
+// UpdateCampaign 覆盖活动基本信息与状态；上架前需要配置奖池，结束的活动不能重新开启
+func (uc *BoxUseCase) UpdateCampaign(ctx context.Context, req *pb.UpdateCampaignRequest) (*pb.UpdateCampaignReply, error) {
+	var res *model.Campaign
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		c, err := uc.campaigns.GetForUpdate(ctx, req.Id)
+		if err != nil {
+			return err
+		}
+		status := int32(req.Status)
+		switch {
+		case c.Status == model.CampaignClosed && status != model.CampaignClosed:
+			return pb.ErrorBoxInvalidParams("campaign %d is closed", c.ID)
+		case status == model.CampaignActive && c.PoolVersion == 0:
+			return pb.ErrorBoxPoolInvalid("campaign %d has no pool", c.ID)
+		}
+		if err := uc.fillCampaign(c, req.Name, req.Description, req.Price, toMilli(req.StartTime), toMilli(req.EndTime), req.MaxOpen); err != nil {
+			return err
+		}
+		c.Status = status
+		res, err = uc.campaigns.Update(ctx, c)
+		return err
+	})
+	if err != nil {
+		return &pb.UpdateCampaignReply{}, err
+	}
+	return &pb.UpdateCampaignReply{Campaign: uc.campaignDetail(ctx, res)}, nil
+}
+
+func (uc *BoxUseCase) fillCampaign(c *model.Campaign, name, description string, price *commonv1.Money, start, end int64, maxOpen int32) error {
+	if price == nil {
+		price = &commonv1.Money{Currency: uc.currency()}
+	}
+	m, err := money.FromProto(price)
+	if err != nil || m.IsNegative() {
+		return pb.ErrorBoxInvalidParams("invalid price")
+	}
+	if end > 0 && end <= start {
+		return pb.ErrorBoxInvalidParams("end time must be after start time")
+	}
+	if maxOpen < 0 {
+		return pb.ErrorBoxInvalidParams("invalid max open")
+	}
+	c.Name = name
+	c.Description = description
+	c.Price = m.Amount()
+	c.Currency = string(m.Currency())
+	c.StartTime = start
+	c.EndTime = end
+	c.MaxOpen = maxOpen
+	return nil
+}
+
+// SetPool 替换奖池：写入新版本的奖品与保底规则，新版本使用独立的库存；进行中的活动需先暂停
+func (uc *BoxUseCase) SetPool(ctx context.Context, req *pb.SetPoolRequest) (*pb.SetPoolReply, error) {
+	// 先以下标作为奖品ID校验，写入后替换为真实ID
+	pool := mystery.Pool{}
+	for i, p := range req.Prizes {
+		pool.Prizes = append(pool.Prizes, mystery.Prize{PlayerId: int64(i), Weight: int(p.Weight), Stock: p.Stock})
+	}
+	for _, r := range req.PityRules {
+		rule := mystery.PityRule{Name: r.Name, Ceiling: int(r.Ceiling), SoftStart: int(r.SoftStart), SoftStep: int(r.SoftStep)}
+		for _, idx := range r.PrizeIndexes {
+			rule.Prizes = append(rule.Prizes, int64(idx))
+		}
+		pool.Rules = append(pool.Rules, rule)
+	}
+	if err := pool.Validate(); err != nil {
+		return &pb.SetPoolReply{}, pb.ErrorBoxPoolInvalid(err.Error())
+	}
+
+	var res *model.Campaign
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		c, err := uc.campaigns.GetForUpdate(ctx, req.CampaignId)
+		if err != nil {
+			return err
+		}
+		if c.Status == model.CampaignActive {
+			return pb.ErrorBoxPoolLocked("pause campaign %d before changing its pool", c.ID)
+		}
+
+		c.PoolVersion++
+		prizes := make([]*model.Prize, 0, len(req.Prizes))
+		for _, p := range req.Prizes {
+			prizes = append(prizes, &model.Prize{
+				CampaignID:  c.ID,
+				PoolVersion: c.PoolVersion,
+				Name:        p.Name,
+				Image:       p.Image,
+				Weight:      p.Weight,
+				Stock:       p.Stock,
+			})
+		}
+		if err := uc.campaigns.CreatePrizes(ctx, prizes); err != nil {
+			return err
+		}
+
+		rules := make([]model.PityRule, 0, len(pool.Rules))
+		for _, r := range pool.Rules {
+			rule := model.PityRule{Name: r.Name, Ceiling: r.Ceiling, SoftStart: r.SoftStart, SoftStep: r.SoftStep}
+			for _, idx := range r.Prizes {
+				rule.PrizeIDs = append(rule.PrizeIDs, prizes[idx].ID)
+			}
+			rules = append(rules, rule)
+		}
+		c.PityRules = encodeJSON(rules)
+		if res, err = uc.campaigns.Update(ctx, c); err != nil {
+			return err
+		}
+
+		// 提交后预先写入库存，失败时由首次开盒重建
+		stock := toPool(prizes, rules).Prizes
+		uc.tx.AfterCommit(ctx, func(ctx context.Context) {
+			if err := uc.stocks.Init(ctx, c.ID, c.PoolVersion, stock); err != nil {
+				uc.log.WithContext(ctx).Errorf("[SetPool] init stock of campaign %d version %d: %v", c.ID, c.PoolVersion, err)
+			}
+		})
+		return nil
+	})
+	if err != nil {
+		return &pb.SetPoolReply{}, err
+	}
+	return &pb.SetPoolReply{Campaign: uc.campaignDetail(ctx, res)}, nil
+}
@@ -0,0 +1,60 @@
+package biz
+
+import (
+	"casso/app/box/service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+// ProviderSet is biz providers.
+var ProviderSet = wire.NewSet(NewBoxUseCase)
+
+const (
+	defaultMaxOpen  = 10
+	defaultCurrency = "CNY"
+)
+
+type BoxUseCase struct {
+	campaigns CampaignRepo
+	players   PlayerRepo
+	stocks    StockRepo
+	tx        Transaction
+	engines   *engineCache
+	conf      *conf.Box
+	log       *log.Helper
+}
+
+func NewBoxUseCase(campaigns CampaignRepo, players PlayerRepo, stocks StockRepo, tx Transaction, c *conf.Box, logger log.Logger) *BoxUseCase {
+	if c == nil {
+		c = &conf.Box{}
+	}
+	uc := &BoxUseCase{
+		campaigns: campaigns,
+		players:   players,
+		stocks:    stocks,
+		tx:        tx,
+		conf:      c,
+		log:       log.NewHelper(log.With(logger, "module", "usecase/box")),
+	}
+	uc.engines = newEngineCache(uc)
+	return uc
+}
+
+// maxOpen 活动单次开盒上限，活动未配置时使用全局配置
+func (uc *BoxUseCase) maxOpen(campaignMax int32) int32 {
+	if campaignMax > 0 {
+		return campaignMax
+	}
+	if uc.conf.MaxOpen > 0 {
+		return uc.conf.MaxOpen
+	}
+	return defaultMaxOpen
+}
+
+func (uc *BoxUseCase) currency() string {
+	if uc.conf.Currency != "" {
+		return uc.conf.Currency
+	}
+	return defaultCurrency
+}
@@ -0,0 +1,427 @@
+package biz
+
+import (
+	pb "casso/api/box/service/v1"
+	"casso/app/box/service/internal/model"
+	"casso/pkg/money"
+	"casso/pkg/util/mystery"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ********* 玩家接口：活动展示、开盒、抽奖记录与种子 ***********
+
+func (uc *BoxUseCase) ListCampaigns(ctx context.Context, req *pb.ListCampaignsRequest) (*pb.ListCampaignsReply, error) {
+	list, err := uc.campaigns.List(ctx, !req.All, req.Page, req.Limit)
+	if err != nil {
+		return &pb.ListCampaignsReply{}, err
+	}
+	reply := &pb.ListCampaignsReply{}
+	for _, c := range list {
+		reply.Campaigns = append(reply.Campaigns, campaignToProto(c, nil, nil))
+	}
+	return reply, nil
+}
+
+func (uc *BoxUseCase) GetCampaign(ctx context.Context, id int64) (*pb.GetCampaignReply, error) {
+	c, err := uc.campaigns.Get(ctx, id)
+	if err != nil {
+		return &pb.GetCampaignReply{}, err
+	}
+	return &pb.GetCampaignReply{Campaign: uc.campaignDetail(ctx, c)}, nil
+}
+
+// campaignDetail 活动详情，包括当前奖池、概率与剩余库存
+func (uc *BoxUseCase) campaignDetail(ctx context.Context, c *model.Campaign) *pb.Campaign {
+	if c.PoolVersion == 0 {
+		return campaignToProto(c, nil, nil)
+	}
+	prizes, err := uc.campaigns.ListPrizes(ctx, c.ID, c.PoolVersion)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("[campaignDetail] list prizes of campaign %d: %v", c.ID, err)
+		return campaignToProto(c, nil, nil)
+	}
+	remaining, err := uc.stocks.Remaining(ctx, c.ID, c.PoolVersion)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("[campaignDetail] remaining stock of campaign %d: %v", c.ID, err)
+	}
+	return campaignToProto(c, prizes, remaining)
+}
+
+// OpenBox 开盒：连开的结果全部写入或全部失败，失败时归还已扣减的库存
+func (uc *BoxUseCase) OpenBox(ctx context.Context, req *pb.OpenBoxRequest) (*pb.OpenBoxReply, error) {
+	// 重复提交直接返回首次结果
+	if reply, err := uc.replayOrder(ctx, req.UserId, req.RequestId); err != nil || reply != nil {
+		return reply, err
+	}
+
+	campaign, err := uc.campaigns.Get(ctx, req.CampaignId)
+	if err != nil {
+		return &pb.OpenBoxReply{}, err
+	}
+	if err := checkActive(campaign); err != nil {
+		return &pb.OpenBoxReply{}, err
+	}
+	if max := uc.maxOpen(campaign.MaxOpen); req.Count > max {
+		return &pb.OpenBoxReply{}, pb.ErrorBoxTooMany("open at most %d boxes at once", max).
+			WithMetadata(map[string]string{"max": strconv.Itoa(int(max))})
+	}
+	amount, err := money.New(campaign.Price, money.Currency(campaign.Currency)).Mul(int64(req.Count))
+	if err != nil {
+		return &pb.OpenBoxReply{}, pb.ErrorBoxInvalidParams("invalid count")
+	}
+	pe, err := uc.engines.get(ctx, campaign)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("[OpenBox] build engine of campaign %d: %v", campaign.ID, err)
+		return &pb.OpenBoxReply{}, err
+	}
+	fresh, err := newPlayer(campaign.ID, req.UserId)
+	if err != nil {
+		return &pb.OpenBoxReply{}, err
+	}
+
+	order := &model.Order{
+		UserID:     req.UserId,
+		RequestID:  req.RequestId,
+		CampaignID: campaign.ID,
+		Count:      req.Count,
+		Amount:     amount.Amount(),
+		Currency:   string(amount.Currency()),
+	}
+	var (
+		draws []*model.Draw
+		taken []int64 // 已扣减库存的奖品
+	)
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		// 同一玩家的开盒串行执行，保证保底计数与序号连续
+		player, err := uc.players.Lock(ctx, fresh)
+		if err != nil {
+			return err
+		}
+		state, err := decodeState(player.Pity)
+		if err != nil {
+			return err
+		}
+		seed, err := hex.DecodeString(player.ServerSeed)
+		if err != nil {
+			return err
+		}
+
+		src := mystery.NewFairSource(seed, player.ClientSeed, player.Nonce)
+		for i := int32(0); i < req.Count; i++ {
+			before, nonce := encodeJSON(state), src.Nonce()
+			res, err := pe.engine.Draw(ctx, state, src)
+			if err != nil {
+				return err
+			}
+			taken = append(taken, res.PrizeID)
+			draws = append(draws, &model.Draw{
+				UserID:     req.UserId,
+				CampaignID: campaign.ID,
+				PrizeID:    res.PrizeID,
+				Nonce:      nonce,
+				Roll:       res.Roll,
+				Pity:       res.Pity,
+				SoldOut:    encodeIDs(res.SoldOut),
+				PityBefore: before,
+				Commitment: player.Commitment,
+				ClientSeed: player.ClientSeed,
+			})
+		}
+
+		player.Pity = encodeJSON(state)
+		player.Nonce = src.Nonce()
+		if err := uc.players.Save(ctx, player); err != nil {
+			return err
+		}
+		return uc.players.CreateOrder(ctx, order, draws)
+	})
+	if err != nil {
+		uc.returnStock(campaign, taken)
+		switch {
+		case errors.Is(err, ErrDuplicateRequest):
+			// 并发的重复提交，等待首个请求提交后返回其结果
+			return uc.replayOrder(ctx, req.UserId, req.RequestId)
+		case errors.Is(err, mystery.ErrSoldOut):
+			return &pb.OpenBoxReply{}, pb.ErrorBoxSoldOut("not enough stock for %d boxes", req.Count)
+		}
+		uc.log.WithContext(ctx).Errorf("[OpenBox] user %d campaign %d: %v", req.UserId, campaign.ID, err)
+		return &pb.OpenBoxReply{}, err
+	}
+
+	prizes := make(map[int64]*model.Prize, len(pe.prizes))
+	for _, p := range pe.prizes {
+		prizes[p.ID] = p
+	}
+	return orderToProto(order, draws, prizes, false), nil
+}
+
+// returnStock 事务失败时归还库存；请求可能已取消，使用独立的 context
+func (uc *BoxUseCase) returnStock(campaign *model.Campaign, prizeIDs []int64) {
+	if len(prizeIDs) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	stock := uc.stocks.Stock(campaign.ID, campaign.PoolVersion)
+	for _, id := range prizeIDs {
+		if err := stock.Return(ctx, id); err != nil {
+			uc.log.Errorf("[returnStock] campaign %d prize %d: %v", campaign.ID, id, err)
+		}
+	}
+}
+
+// replayOrder 按幂等键返回已有订单，不存在时返回 nil
+func (uc *BoxUseCase) replayOrder(ctx context.Context, userID int64, requestID string) (*pb.OpenBoxReply, error) {
+	order, draws, err := uc.players.GetOrder(ctx, userID, requestID)
+	if err != nil {
+		return &pb.OpenBoxReply{}, err
+	}
+	if order == nil {
+		return nil, nil
+	}
+	prizes, err := uc.campaigns.GetPrizes(ctx, drawPrizeIDs(draws))
+	if err != nil {
+		return &pb.OpenBoxReply{}, err
+	}
+	return orderToProto(order, draws, prizes, true), nil
+}
+
+func (uc *BoxUseCase) ListDraws(ctx context.Context, req *pb.ListDrawsRequest) (*pb.ListDrawsReply, error) {
+	draws, err := uc.players.ListDraws(ctx, req.UserId, req.CampaignId, req.Page, req.Limit)
+	if err != nil {
+		return &pb.ListDrawsReply{}, err
+	}
+	prizes, err := uc.campaigns.GetPrizes(ctx, drawPrizeIDs(draws))
+	if err != nil {
+		return &pb.ListDrawsReply{}, err
+	}
+	reply := &pb.ListDrawsReply{}
+	for _, d := range draws {
+		reply.Draws = append(reply.Draws, drawToProto(d, prizes))
+	}
+	return reply, nil
+}
+
+// GetSeed 当前种子承诺，首次查询时为玩家生成种子，玩家可在开盒前记录承诺
+func (uc *BoxUseCase) GetSeed(ctx context.Context, userID, campaignID int64) (*pb.GetSeedReply, error) {
+	if _, err := uc.campaigns.Get(ctx, campaignID); err != nil {
+		return &pb.GetSeedReply{}, err
+	}
+	fresh, err := newPlayer(campaignID, userID)
+	if err != nil {
+		return &pb.GetSeedReply{}, err
+	}
+	var player *model.Player
+	err = uc.tx.InTx(ctx, func(ctx context.Context) (err error) {
+		player, err = uc.players.Lock(ctx, fresh)
+		return err
+	})
+	if err != nil {
+		return &pb.GetSeedReply{}, err
+	}
+	return seedToProto(player), nil
+}
+
+// RotateSeed 公开当前服务端种子并换用新种子，公开后玩家可以校验此前全部抽奖；保底计数不受影响
+func (uc *BoxUseCase) RotateSeed(ctx context.Context, req *pb.RotateSeedRequest) (*pb.RotateSeedReply, error) {
+	if _, err := uc.campaigns.Get(ctx, req.CampaignId); err != nil {
+		return &pb.RotateSeedReply{}, err
+	}
+	fresh, err := newPlayer(req.CampaignId, req.UserId)
+	if err != nil {
+		return &pb.RotateSeedReply{}, err
+	}
+	next, err := newPlayer(req.CampaignId, req.UserId)
+	if err != nil {
+		return &pb.RotateSeedReply{}, err
+	}
+
+	reply := &pb.RotateSeedReply{}
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		player, err := uc.players.Lock(ctx, fresh)
+		if err != nil {
+			return err
+		}
+		reply.ServerSeed = player.ServerSeed
+		reply.Commitment = player.Commitment
+		reply.ClientSeed = player.ClientSeed
+		reply.Nonce = player.Nonce
+
+		player.ServerSeed, player.Commitment, player.Nonce = next.ServerSeed, next.Commitment, 0
+		if req.ClientSeed != "" {
+			player.ClientSeed = req.ClientSeed
+		}
+		if err := uc.players.Save(ctx, player); err != nil {
+			return err
+		}
+		reply.Next = seedToProto(player)
+		return nil
+	})
+	if err != nil {
+		return &pb.RotateSeedReply{}, err
+	}
+	return reply, nil
+}
+
+// newPlayer 新玩家状态：随机服务端种子与默认玩家种子
+func newPlayer(campaignID, userID int64) (*model.Player, error) {
+	seed, err := mystery.NewServerSeed()
+	if err != nil {
+		return nil, err
+	}
+	client := make([]byte, 8)
+	if _, err := rand.Read(client); err != nil {
+		return nil, err
+	}
+	return &model.Player{
+		CampaignID: campaignID,
+		UserID:     userID,
+		Pity:       encodeJSON(mystery.State{}),
+		ServerSeed: hex.EncodeToString(seed),
+		Commitment: mystery.Commit(seed),
+		ClientSeed: hex.EncodeToString(client),
+	}, nil
+}
+
+// checkActive 活动进行中且在开放时间内
+func checkActive(c *model.Campaign) error {
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	switch {
+	case c.Status != model.CampaignActive:
+		return pb.ErrorBoxCampaignNotActive("campaign %d is not active", c.ID)
+	case c.StartTime > 0 && now < c.StartTime:
+		return pb.ErrorBoxCampaignNotActive("campaign %d has not started", c.ID)
+	case c.EndTime > 0 && now >= c.EndTime:
+		return pb.ErrorBoxCampaignNotActive("campaign %d has ended", c.ID)
+	}
+	return nil
+}
+
+// encodeIDs 售罄排除的奖品，大多数抽奖没有排除，存空字符串
+func encodeIDs(ids []int64) string {
+	if len(ids) == 0 {
+		return ""
+	}
+	return encodeJSON(ids)
+}
+
+func drawPrizeIDs(draws []*model.Draw) []int64 {
+	ids := make([]int64, 0, len(draws))
+	for _, d := range draws {
+		ids = append(ids, d.PrizeID)
+	}
+	return ids
+}
+
+func campaignToProto(c *model.Campaign, prizes []*model.Prize, remaining map[int64]int64) *pb.Campaign {
+	out := &pb.Campaign{
+		Id:          c.ID,
+		Name:        c.Name,
+		Description: c.Description,
+		Price:       money.New(c.Price, money.Currency(c.Currency)).ToProto(),
+		Status:      pb.CampaignStatus(c.Status),
+		StartTime:   fromMilli(c.StartTime),
+		EndTime:     fromMilli(c.EndTime),
+		MaxOpen:     c.MaxOpen,
+		PoolVersion: c.PoolVersion,
+	}
+	if prizes == nil {
+		return out
+	}
+
+	var total int64
+	for _, p := range prizes {
+		total += int64(p.Weight)
+	}
+	for _, p := range prizes {
+		left, ok := remaining[p.ID]
+		if !ok {
+			left = p.Stock
+		}
+		prize := &pb.Prize{
+			Id:        p.ID,
+			Name:      p.Name,
+			Image:     p.Image,
+			Weight:    p.Weight,
+			Stock:     p.Stock,
+			Remaining: left,
+		}
+		if total > 0 {
+			prize.Probability = float64(p.Weight) / float64(total)
+		}
+		out.Prizes = append(out.Prizes, prize)
+	}
+	rules, _ := decodeRules(c.PityRules)
+	for _, r := range rules {
+		out.PityRules = append(out.PityRules, &pb.PityRule{
+			Name:      r.Name,
+			PrizeIds:  r.PrizeIDs,
+			Ceiling:   int32(r.Ceiling),
+			SoftStart: int32(r.SoftStart),
+			SoftStep:  int32(r.SoftStep),
+		})
+	}
+	return out
+}
+
+func orderToProto(o *model.Order, draws []*model.Draw, prizes map[int64]*model.Prize, replayed bool) *pb.OpenBoxReply {
+	reply := &pb.OpenBoxReply{
+		OrderId:  o.ID,
+		Amount:   money.New(o.Amount, money.Currency(o.Currency)).ToProto(),
+		Replayed: replayed,
+	}
+	for _, d := range draws {
+		reply.Draws = append(reply.Draws, drawToProto(d, prizes))
+	}
+	return reply
+}
+
+func drawToProto(d *model.Draw, prizes map[int64]*model.Prize) *pb.Draw {
+	out := &pb.Draw{
+		Id:         d.ID,
+		OrderId:    d.OrderID,
+		UserId:     d.UserID,
+		CampaignId: d.CampaignID,
+		PrizeId:    d.PrizeID,
+		Nonce:      d.Nonce,
+		Roll:       d.Roll,
+		Pity:       d.Pity,
+		Commitment: d.Commitment,
+		ClientSeed: d.ClientSeed,
+		CreatedAt:  fromMilli(d.CreatedTime),
+	}
+	if p, ok := prizes[d.PrizeID]; ok {
+		out.PrizeName = p.Name
+	}
+	if d.SoldOut != "" {
+		_ = json.Unmarshal([]byte(d.SoldOut), &out.SoldOut)
+	}
+	return out
+}
+
+func seedToProto(p *model.Player) *pb.GetSeedReply {
+	return &pb.GetSeedReply{Commitment: p.Commitment, ClientSeed: p.ClientSeed, Nonce: p.Nonce}
+}
+
+// fromMilli 毫秒时间戳转换，0 表示未设置
+func fromMilli(ms int64) *timestamppb.Timestamp {
+	if ms == 0 {
+		return nil
+	}
+	return timestamppb.New(time.Unix(0, ms*int64(time.Millisecond)))
+}
+
+func toMilli(ts *timestamppb.Timestamp) int64 {
+	if ts == nil {
+		return 0
+	}
+	return ts.AsTime().UnixNano() / int64(time.Millisecond)
+}
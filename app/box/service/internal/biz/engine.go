@@ -0,0 +1,149 @@
+package biz
+
+import (
+	"casso/app/box/service/internal/model"
+	"casso/pkg/util/mystery"
+	"context"
+	"encoding/json"
+	"sync"
+)
+
+// poolEngine 某个奖池版本的抽奖引擎
+type poolEngine struct {
+	version int64
+	engine  *mystery.Engine
+	prizes  []*model.Prize
+}
+
+// engineCache 按活动缓存抽奖引擎，奖池版本变化时重建；
+// 每个活动单独加锁，重建时只阻塞同一活动的请求
+type engineCache struct {
+	uc      *BoxUseCase
+	mu      sync.Mutex
+	engines map[int64]*campaignEngine
+}
+
+type campaignEngine struct {
+	mu     sync.Mutex
+	engine *poolEngine
+}
+
+func newEngineCache(uc *BoxUseCase) *engineCache {
+	return &engineCache{uc: uc, engines: map[int64]*campaignEngine{}}
+}
+
+func (c *engineCache) get(ctx context.Context, campaign *model.Campaign) (*poolEngine, error) {
+	c.mu.Lock()
+	ce, ok := c.engines[campaign.ID]
+	if !ok {
+		ce = &campaignEngine{}
+		c.engines[campaign.ID] = ce
+	}
+	c.mu.Unlock()
+
+	ce.mu.Lock()
+	defer ce.mu.Unlock()
+	if ce.engine != nil && ce.engine.version == campaign.PoolVersion {
+		return ce.engine, nil
+	}
+	e, err := c.uc.buildEngine(ctx, campaign)
+	if err != nil {
+		return nil, err
+	}
+	ce.engine = e
+	return e, nil
+}
+
+func (uc *BoxUseCase) buildEngine(ctx context.Context, campaign *model.Campaign) (*poolEngine, error) {
+	prizes, err := uc.campaigns.ListPrizes(ctx, campaign.ID, campaign.PoolVersion)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := decodeRules(campaign.PityRules)
+	if err != nil {
+		return nil, err
+	}
+	pool := toPool(prizes, rules)
+	if err := uc.restoreStock(ctx, campaign, pool.Prizes); err != nil {
+		return nil, err
+	}
+
+	engine, err := mystery.NewEngine(pool, uc.stocks.Stock(campaign.ID, campaign.PoolVersion))
+	if err != nil {
+		return nil, err
+	}
+	return &poolEngine{version: campaign.PoolVersion, engine: engine, prizes: prizes}, nil
+}
+
+// restoreStock redis 中没有库存时按初始库存减去已抽出的数量重建，
+// 避免库存丢失后奖品被当作不限量
+func (uc *BoxUseCase) restoreStock(ctx context.Context, campaign *model.Campaign, prizes []mystery.Prize) error {
+	remaining, err := uc.stocks.Remaining(ctx, campaign.ID, campaign.PoolVersion)
+	if err != nil {
+		return err
+	}
+	if len(remaining) > 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(prizes))
+	for _, p := range prizes {
+		ids = append(ids, p.PlayerId)
+	}
+	drawn, err := uc.players.CountDraws(ctx, ids)
+	if err != nil {
+		return err
+	}
+	stock := make([]mystery.Prize, len(prizes))
+	for i, p := range prizes {
+		stock[i] = p
+		if p.Stock != mystery.Unlimited {
+			stock[i].Stock = p.Stock - drawn[p.PlayerId]
+			if stock[i].Stock < 0 {
+				stock[i].Stock = 0
+			}
+		}
+	}
+	uc.log.WithContext(ctx).Warnf("restore stock of campaign %d version %d", campaign.ID, campaign.PoolVersion)
+	return uc.stocks.Init(ctx, campaign.ID, campaign.PoolVersion, stock)
+}
+
+func toPool(prizes []*model.Prize, rules []model.PityRule) mystery.Pool {
+	pool := mystery.Pool{Prizes: make([]mystery.Prize, 0, len(prizes))}
+	for _, p := range prizes {
+		pool.Prizes = append(pool.Prizes, mystery.Prize{PlayerId: p.ID, Weight: int(p.Weight), Stock: p.Stock})
+	}
+	for _, r := range rules {
+		pool.Rules = append(pool.Rules, mystery.PityRule{
+			Name:      r.Name,
+			Prizes:    r.PrizeIDs,
+			Ceiling:   r.Ceiling,
+			SoftStart: r.SoftStart,
+			SoftStep:  r.SoftStep,
+		})
+	}
+	return pool
+}
+
+func decodeRules(s string) ([]model.PityRule, error) {
+	var rules []model.PityRule
+	if s == "" {
+		return rules, nil
+	}
+	err := json.Unmarshal([]byte(s), &rules)
+	return rules, err
+}
+
+func decodeState(s string) (mystery.State, error) {
+	state := mystery.State{}
+	if s == "" {
+		return state, nil
+	}
+	err := json.Unmarshal([]byte(s), &state)
+	return state, err
+}
+
+func encodeJSON(v interface{}) string {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
@@ -0,0 +1,63 @@
+package biz
+
+import (
+	"casso/app/box/service/internal/model"
+	"casso/pkg/util/mystery"
+	"context"
+	"errors"
+)
+
+// ErrDuplicateRequest 同一用户的幂等键已存在订单
+var ErrDuplicateRequest = errors.New("box: duplicate request")
+
+// Transaction 事务，由data层实现；在 fn 内通过 ctx 调用的 repo 方法会加入同一个事务
+type Transaction interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+	// AfterCommit 事务提交后执行，回滚时不执行
+	AfterCommit(ctx context.Context, fn func(ctx context.Context))
+}
+
+// CampaignRepo 活动与奖池
+type CampaignRepo interface {
+	// 新建活动
+	Create(ctx context.Context, c *model.Campaign) (*model.Campaign, error)
+	// 更新活动，包括奖池版本与保底规则
+	Update(ctx context.Context, c *model.Campaign) (*model.Campaign, error)
+	// 获取活动，不存在时返回 BOX_CAMPAIGN_NOT_FOUND
+	Get(ctx context.Context, id int64) (*model.Campaign, error)
+	// 加行锁获取活动，需要在事务中调用
+	GetForUpdate(ctx context.Context, id int64) (*model.Campaign, error)
+	// 活动列表，activeOnly 为 true 时只返回进行中的活动
+	List(ctx context.Context, activeOnly bool, pageNum, pageSize int64) ([]*model.Campaign, error)
+	// 写入奖品，写入后回填ID
+	CreatePrizes(ctx context.Context, prizes []*model.Prize) error
+	// 某个版本的奖池
+	ListPrizes(ctx context.Context, campaignID, version int64) ([]*model.Prize, error)
+	// 按ID获取奖品，包括历史版本
+	GetPrizes(ctx context.Context, ids []int64) (map[int64]*model.Prize, error)
+}
+
+// PlayerRepo 玩家状态、订单与抽奖记录
+type PlayerRepo interface {
+	// 加行锁获取玩家状态，不存在时写入 fresh；需要在事务中调用
+	Lock(ctx context.Context, fresh *model.Player) (*model.Player, error)
+	// 保存保底计数、种子与序号
+	Save(ctx context.Context, p *model.Player) error
+	// 写入订单与抽奖记录，幂等键重复时返回 ErrDuplicateRequest
+	CreateOrder(ctx context.Context, o *model.Order, draws []*model.Draw) error
+	// 按幂等键获取订单与抽奖记录，不存在时返回 nil
+	GetOrder(ctx context.Context, userID int64, requestID string) (*model.Order, []*model.Draw, error)
+	// 抽奖记录，campaignID 为 0 时返回全部活动，按时间倒序
+	ListDraws(ctx context.Context, userID, campaignID int64, pageNum, pageSize int64) ([]*model.Draw, error)
+	// 各奖品已抽出的数量，用于库存丢失时重建
+	CountDraws(ctx context.Context, prizeIDs []int64) (map[int64]int64, error)
+}
+
+// StockRepo 奖池库存，每个奖池版本一份
+type StockRepo interface {
+	Stock(campaignID, version int64) mystery.Stock
+	// 写入初始库存，已存在的奖品不覆盖
+	Init(ctx context.Context, campaignID, version int64, prizes []mystery.Prize) error
+	// 剩余库存，库存未初始化时返回空
+	Remaining(ctx context.Context, campaignID, version int64) (map[int64]int64, error)
+}
@@ -0,0 +1,43 @@
+package conf
+
+import (
+	"casso/pkg/registry"
+	"casso/pkg/util/transaction"
+	"errors"
+	"fmt"
+)
+
+// Validate 由 pkg/config 在加载、重新加载时调用，不合法的配置不生效
+func (x *Bootstrap) Validate() error {
+	if x.GetServer().GetGrpc().GetAddr() == "" {
+		return errors.New("conf: server.grpc.addr is required")
+	}
+	db := x.GetData().GetDatabase()
+	if db.GetSource() == "" {
+		return errors.New("conf: data.database.source is required")
+	}
+	if _, err := transaction.ParseIsolation(db.GetIsolation()); err != nil {
+		return fmt.Errorf("conf: data.database.isolation: %w", err)
+	}
+	if x.GetData().GetRedis().GetAddr() == "" {
+		return errors.New("conf: data.redis.addr is required")
+	}
+	if x.GetBox().GetMaxOpen() < 0 {
+		return errors.New("conf: box.max_open must not be negative")
+	}
+	if err := x.GetTrace().Validate(); err != nil {
+		return err
+	}
+	if err := x.GetConfig().Validate(); err != nil {
+		return err
+	}
+	if err := x.GetSecret().Validate(); err != nil {
+		return err
+	}
+	return x.GetLog().Validate()
+}
+
+// Validate registry.yaml
+func (x *Registry) Validate() error {
+	return registry.Validate(x)
+}
@@ -0,0 +1,124 @@
+package data
+
+import (
+	v1 "casso/api/box/service/v1"
+	"casso/app/box/service/internal/biz"
+	"casso/app/box/service/internal/model"
+	"casso/pkg/errors"
+	"casso/pkg/util/pagination"
+	"context"
+	stderrors "errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ biz.CampaignRepo = (*CampaignRepo)(nil)
+
+type CampaignRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewCampaignRepo(data *Data, logger log.Logger) biz.CampaignRepo {
+	return &CampaignRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data/campaign")),
+	}
+}
+
+func (r *CampaignRepo) Create(ctx context.Context, c *model.Campaign) (*model.Campaign, error) {
+	if err := r.data.DB(ctx).Create(c).Error; err != nil {
+		r.log.Errorf("[Create] fail: %v", err)
+		return &model.Campaign{}, errors.UnknownError
+	}
+	return c, nil
+}
+
+func (r *CampaignRepo) Update(ctx context.Context, c *model.Campaign) (*model.Campaign, error) {
+	// Save 写入全部字段，清空结束时间等零值也能生效
+	if err := r.data.DB(ctx).Save(c).Error; err != nil {
+		r.log.Errorf("[Update] fail: %v", err)
+		return &model.Campaign{}, errors.UnknownError
+	}
+	return c, nil
+}
+
+func (r *CampaignRepo) Get(ctx context.Context, id int64) (*model.Campaign, error) {
+	return r.get(r.data.DB(ctx), id)
+}
+
+func (r *CampaignRepo) GetForUpdate(ctx context.Context, id int64) (*model.Campaign, error) {
+	return r.get(r.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
+
+func (r *CampaignRepo) get(db *gorm.DB, id int64) (*model.Campaign, error) {
+	c := model.Campaign{}
+	err := db.First(&c, id).Error
+	if stderrors.Is(err, gorm.ErrRecordNotFound) {
+		return &model.Campaign{}, v1.ErrorBoxCampaignNotFound("campaign %d not found", id)
+	}
+	if err != nil {
+		r.log.Errorf("[Get] fail: %v", err)
+		return &model.Campaign{}, errors.UnknownError
+	}
+	return &c, nil
+}
+
+func (r *CampaignRepo) List(ctx context.Context, activeOnly bool, pageNum, pageSize int64) ([]*model.Campaign, error) {
+	var list []*model.Campaign
+	db := r.data.DB(ctx)
+	if activeOnly {
+		db = db.Where("status = ?", model.CampaignActive)
+	}
+	err := db.Order("id desc").
+		Offset(int(pagination.GetPageOffset(pageNum, pageSize))).
+		Limit(int(pageSize)).
+		Find(&list).Error
+	if err != nil {
+		r.log.Errorf("[List] fail: %v", err)
+		return nil, errors.UnknownError
+	}
+	return list, nil
+}
+
+func (r *CampaignRepo) CreatePrizes(ctx context.Context, prizes []*model.Prize) error {
+	if len(prizes) == 0 {
+		return nil
+	}
+	if err := r.data.DB(ctx).Create(&prizes).Error; err != nil {
+		r.log.Errorf("[CreatePrizes] fail: %v", err)
+		return errors.UnknownError
+	}
+	return nil
+}
+
+func (r *CampaignRepo) ListPrizes(ctx context.Context, campaignID, version int64) ([]*model.Prize, error) {
+	var prizes []*model.Prize
+	err := r.data.DB(ctx).
+		Where("campaign_id = ? AND pool_version = ?", campaignID, version).
+		Order("id").
+		Find(&prizes).Error
+	if err != nil {
+		r.log.Errorf("[ListPrizes] fail: %v", err)
+		return nil, errors.UnknownError
+	}
+	return prizes, nil
+}
+
+func (r *CampaignRepo) GetPrizes(ctx context.Context, ids []int64) (map[int64]*model.Prize, error) {
+	out := make(map[int64]*model.Prize, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+	var prizes []*model.Prize
+	if err := r.data.DB(ctx).Where("id IN ?", ids).Find(&prizes).Error; err != nil {
+		r.log.Errorf("[GetPrizes] fail: %v", err)
+		return nil, errors.UnknownError
+	}
+	for _, p := range prizes {
+		out[p.ID] = p
+	}
+	return out, nil
+}
@@ -0,0 +1,79 @@
+package data
+
+import (
+	"casso/app/box/service/internal/biz"
+	"casso/app/box/service/internal/conf"
+	"casso/pkg/util/transaction"
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+	"gorm.io/gorm"
+
+	// init mysql driver
+	_ "github.com/go-sql-driver/mysql"
+)
+
+// ProviderSet is data providers.
+var ProviderSet = wire.NewSet(NewData, NewDB, NewRd, NewTransaction, NewCampaignRepo, NewPlayerRepo, NewStockRepo, NewHealth)
+
+// Data .
+type Data struct {
+	rd  *redis.Client
+	db  *gorm.DB
+	tm  *transaction.Manager
+	log *log.Helper
+}
+
+// NewData .
+func NewData(c *conf.Data, db *gorm.DB, rd *redis.Client, logger log.Logger) (*Data, func(), error) {
+	log := log.NewHelper(log.With(logger, "module", "box-service/data"))
+
+	isolation, err := transaction.ParseIsolation(c.Database.Isolation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d := &Data{
+		rd:  rd,
+		db:  db,
+		tm:  transaction.NewManager(db, transaction.WithDefaultIsolation(isolation)),
+		log: log,
+	}
+	return d, func() {
+		// 程序退出，释放资源
+		sqldb, err := d.db.DB()
+		if err != nil {
+			log.Errorf("sqldb resource got fail: %v", err)
+		} else if err := sqldb.Close(); err != nil {
+			log.Errorf("sqldb closing resource got fail: %v", err)
+		}
+
+		if err := d.rd.Close(); err != nil {
+			log.Errorf("redis closing resource got fail: %v", err)
+		}
+
+		log.Info("resource close successed !")
+	}, nil
+}
+
+// NewTransaction 事务由 data 层实现，biz 层只依赖 biz.Transaction 接口
+func NewTransaction(d *Data) biz.Transaction {
+	return d
+}
+
+// InTx 在事务中执行 fn，fn 内通过 ctx 调用的 repo 方法自动加入同一个事务
+func (d *Data) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return d.tm.InTx(ctx, fn)
+}
+
+// AfterCommit 注册事务提交后的回调，不在事务中时立即执行
+func (d *Data) AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	transaction.AfterCommit(ctx, fn)
+}
+
+// DB 获取连接，处于事务中时返回事务连接
+func (d *Data) DB(ctx context.Context) *gorm.DB {
+	return d.tm.DB(ctx)
+}
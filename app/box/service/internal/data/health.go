@@ -0,0 +1,15 @@
+package data
+
+import (
+	"casso/pkg/health"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewHealth 就绪检查：mysql 与 redis
+func NewHealth(d *Data, logger log.Logger) *health.Health {
+	h := health.New(health.WithLogger(logger))
+	h.Register("mysql", health.GORM(d.db))
+	h.Register("redis", health.Redis(d.rd))
+	return h
+}
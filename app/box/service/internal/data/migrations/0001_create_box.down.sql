@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS `box_draw`;
+DROP TABLE IF EXISTS `box_order`;
+DROP TABLE IF EXISTS `box_player`;
+DROP TABLE IF EXISTS `box_prize`;
+DROP TABLE IF EXISTS `box_campaign`;
@@ -0,0 +1,81 @@
+-- 盲盒活动
+CREATE TABLE IF NOT EXISTS `box_campaign` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `name` varchar(64) NOT NULL DEFAULT '' COMMENT '活动名称',
+  `description` varchar(512) NOT NULL DEFAULT '' COMMENT '活动描述',
+  `price` bigint NOT NULL DEFAULT 0 COMMENT '单价，最小货币单位',
+  `currency` varchar(3) NOT NULL DEFAULT '' COMMENT '币种',
+  `status` int NOT NULL DEFAULT 0 COMMENT '状态 0草稿 1进行中 2暂停 3结束',
+  `start_time` bigint NOT NULL DEFAULT 0 COMMENT '开始时间，毫秒，0 不限',
+  `end_time` bigint NOT NULL DEFAULT 0 COMMENT '结束时间，毫秒，0 不限',
+  `max_open` int NOT NULL DEFAULT 0 COMMENT '单次最多开盒数量',
+  `pool_version` bigint NOT NULL DEFAULT 0 COMMENT '奖池版本',
+  `pity_rules` text COMMENT '保底规则 JSON',
+  `updated_time` bigint NOT NULL DEFAULT 0 COMMENT '最后修改时间',
+  `created_time` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 奖品，每次修改奖池写入新版本，旧版本保留用于展示抽奖记录
+CREATE TABLE IF NOT EXISTS `box_prize` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `campaign_id` bigint NOT NULL COMMENT '活动ID',
+  `pool_version` bigint NOT NULL COMMENT '奖池版本',
+  `name` varchar(64) NOT NULL DEFAULT '' COMMENT '奖品名称',
+  `image` varchar(255) NOT NULL DEFAULT '' COMMENT '奖品图片',
+  `weight` int NOT NULL DEFAULT 0 COMMENT '抽取权重',
+  `stock` bigint NOT NULL DEFAULT -1 COMMENT '初始库存，-1 不限量',
+  `created_time` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_campaign_version` (`campaign_id`, `pool_version`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 玩家在活动中的保底计数与种子，开盒时加行锁
+CREATE TABLE IF NOT EXISTS `box_player` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `campaign_id` bigint NOT NULL COMMENT '活动ID',
+  `user_id` bigint NOT NULL COMMENT '用户ID',
+  `pity` text COMMENT '保底计数 JSON',
+  `server_seed` varchar(64) NOT NULL COMMENT '服务端种子，十六进制，轮换前不公开',
+  `commitment` varchar(64) NOT NULL COMMENT '服务端种子承诺',
+  `client_seed` varchar(64) NOT NULL DEFAULT '' COMMENT '玩家种子',
+  `nonce` bigint unsigned NOT NULL DEFAULT 0 COMMENT '下一次抽奖序号',
+  `updated_time` bigint NOT NULL DEFAULT 0 COMMENT '最后修改时间',
+  `created_time` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_campaign_user` (`campaign_id`, `user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 开盒订单，(user_id, request_id) 唯一保证重复提交只开一次
+CREATE TABLE IF NOT EXISTS `box_order` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `user_id` bigint NOT NULL COMMENT '用户ID',
+  `request_id` varchar(64) NOT NULL COMMENT '幂等键',
+  `campaign_id` bigint NOT NULL COMMENT '活动ID',
+  `count` int NOT NULL COMMENT '开盒数量',
+  `amount` bigint NOT NULL COMMENT '金额，最小货币单位',
+  `currency` varchar(3) NOT NULL DEFAULT '' COMMENT '币种',
+  `created_time` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_user_request` (`user_id`, `request_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 抽奖记录
+CREATE TABLE IF NOT EXISTS `box_draw` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `order_id` bigint NOT NULL COMMENT '订单ID',
+  `user_id` bigint NOT NULL COMMENT '用户ID',
+  `campaign_id` bigint NOT NULL COMMENT '活动ID',
+  `prize_id` bigint NOT NULL COMMENT '奖品ID',
+  `nonce` bigint unsigned NOT NULL COMMENT '抽奖序号',
+  `roll` bigint unsigned NOT NULL COMMENT '随机数',
+  `pity` varchar(64) NOT NULL DEFAULT '' COMMENT '触发硬保底的规则',
+  `sold_out` varchar(255) NOT NULL DEFAULT '' COMMENT '售罄排除的奖品 JSON',
+  `pity_before` text COMMENT '抽奖前的保底计数 JSON',
+  `commitment` varchar(64) NOT NULL COMMENT '服务端种子承诺',
+  `client_seed` varchar(64) NOT NULL DEFAULT '' COMMENT '玩家种子',
+  `created_time` bigint NOT NULL DEFAULT 0 COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_order_id` (`order_id`),
+  KEY `idx_user_campaign` (`user_id`, `campaign_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,76 @@
+package data
+
+import (
+	"casso/app/box/service/internal/conf"
+	"casso/pkg/logging"
+	"casso/pkg/metrics"
+	"casso/pkg/tracing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// NewDB 数据库连接，表结构由 cmd/migrate 维护
+func NewDB(conf *conf.Data, mp *metrics.Prometheus, tp *tracesdk.TracerProvider, loggers log.Logger) *gorm.DB {
+	log := log.NewHelper(log.With(loggers, "module", "box-service/data/gorm"))
+	db, err := openDB(conf.Database.Source, conf.Database.Pool, gormLogger(conf, loggers))
+	if err != nil {
+		log.Fatalf("failed opening connection to mysql: %v", err)
+	}
+	if err := mp.GORM(db, "primary"); err != nil {
+		log.Fatalf("failed registering mysql metrics: %v", err)
+	}
+	if err := tracing.GORM(db, tp, "primary"); err != nil {
+		log.Fatalf("failed registering mysql tracing: %v", err)
+	}
+	return db
+}
+
+func openDB(source string, pool *conf.Data_Database_Pool, gl gormlogger.Interface) (*gorm.DB, error) {
+	db, err := gorm.Open(mysql.Open(source), &gorm.Config{
+		Logger: gl, // 只输出失败与慢 SQL，db.Debug() 输出全部
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := db.DB() // 维护链接池
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		maxIdle     = 10        // 空闲最大数量
+		maxOpen     = 100       // 最大链接数
+		maxLifetime = time.Hour // 最大可复用时间
+		maxIdleTime time.Duration
+	)
+	if pool != nil {
+		if pool.MaxIdle > 0 {
+			maxIdle = int(pool.MaxIdle)
+		}
+		if pool.MaxOpen > 0 {
+			maxOpen = int(pool.MaxOpen)
+		}
+		if pool.MaxLifetime != nil {
+			maxLifetime = pool.MaxLifetime.AsDuration()
+		}
+		if pool.MaxIdleTime != nil {
+			maxIdleTime = pool.MaxIdleTime.AsDuration()
+		}
+	}
+	sqlDB.SetMaxIdleConns(maxIdle)
+	sqlDB.SetMaxOpenConns(maxOpen)
+	sqlDB.SetConnMaxLifetime(maxLifetime)
+	sqlDB.SetConnMaxIdleTime(maxIdleTime)
+	return db, nil
+}
+
+// gormLogger SQL 日志输出到服务的 logger，带有 trace_id
+func gormLogger(conf *conf.Data, loggers log.Logger) gormlogger.Interface {
+	return logging.GORM(log.With(loggers, "module", "box-service/data/sql"), conf.Database.SlowThreshold.AsDuration())
+}
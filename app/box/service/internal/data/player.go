@@ -0,0 +1,155 @@
+package data
+
+import (
+	"casso/app/box/service/internal/biz"
+	"casso/app/box/service/internal/model"
+	"casso/pkg/errors"
+	"casso/pkg/util/pagination"
+	"context"
+	stderrors "errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ biz.PlayerRepo = (*PlayerRepo)(nil)
+
+type PlayerRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func isDuplicate(err error) bool {
+	var e *mysql.MySQLError
+	return stderrors.As(err, &e) && e.Number == 1062
+}
+
+func NewPlayerRepo(data *Data, logger log.Logger) biz.PlayerRepo {
+	return &PlayerRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data/player")),
+	}
+}
+
+// Lock 不存在时先写入 fresh，并发写入时唯一索引冲突的一方读取对方写入的记录
+func (r *PlayerRepo) Lock(ctx context.Context, fresh *model.Player) (*model.Player, error) {
+	db := r.data.DB(ctx)
+	p, err := r.lock(db, fresh.CampaignID, fresh.UserID)
+	if err == nil {
+		return p, nil
+	}
+	if !stderrors.Is(err, gorm.ErrRecordNotFound) {
+		r.log.Errorf("[Lock] fail: %v", err)
+		return nil, errors.UnknownError
+	}
+
+	if err := db.Create(fresh).Error; err != nil && !isDuplicate(err) {
+		r.log.Errorf("[Lock] create fail: %v", err)
+		return nil, errors.UnknownError
+	}
+	if p, err = r.lock(db, fresh.CampaignID, fresh.UserID); err != nil {
+		r.log.Errorf("[Lock] fail: %v", err)
+		return nil, errors.UnknownError
+	}
+	return p, nil
+}
+
+func (r *PlayerRepo) lock(db *gorm.DB, campaignID, userID int64) (*model.Player, error) {
+	p := model.Player{}
+	err := db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("campaign_id = ? AND user_id = ?", campaignID, userID).
+		First(&p).Error
+	return &p, err
+}
+
+func (r *PlayerRepo) Save(ctx context.Context, p *model.Player) error {
+	if err := r.data.DB(ctx).Save(p).Error; err != nil {
+		r.log.Errorf("[Save] fail: %v", err)
+		return errors.UnknownError
+	}
+	return nil
+}
+
+func (r *PlayerRepo) CreateOrder(ctx context.Context, o *model.Order, draws []*model.Draw) error {
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		db := r.data.DB(ctx)
+		if err := db.Create(o).Error; err != nil {
+			return err
+		}
+		for _, d := range draws {
+			d.OrderID = o.ID
+		}
+		return db.Create(&draws).Error
+	})
+	if isDuplicate(err) {
+		return biz.ErrDuplicateRequest
+	}
+	if err != nil {
+		r.log.Errorf("[CreateOrder] fail: %v", err)
+		return errors.UnknownError
+	}
+	return nil
+}
+
+func (r *PlayerRepo) GetOrder(ctx context.Context, userID int64, requestID string) (*model.Order, []*model.Draw, error) {
+	db := r.data.DB(ctx)
+	o := model.Order{}
+	err := db.Where("user_id = ? AND request_id = ?", userID, requestID).First(&o).Error
+	if stderrors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		r.log.Errorf("[GetOrder] fail: %v", err)
+		return nil, nil, errors.UnknownError
+	}
+
+	var draws []*model.Draw
+	if err := db.Where("order_id = ?", o.ID).Order("id").Find(&draws).Error; err != nil {
+		r.log.Errorf("[GetOrder] draws fail: %v", err)
+		return nil, nil, errors.UnknownError
+	}
+	return &o, draws, nil
+}
+
+func (r *PlayerRepo) ListDraws(ctx context.Context, userID, campaignID int64, pageNum, pageSize int64) ([]*model.Draw, error) {
+	var draws []*model.Draw
+	db := r.data.DB(ctx).Where("user_id = ?", userID)
+	if campaignID > 0 {
+		db = db.Where("campaign_id = ?", campaignID)
+	}
+	err := db.Order("id desc").
+		Offset(int(pagination.GetPageOffset(pageNum, pageSize))).
+		Limit(int(pageSize)).
+		Find(&draws).Error
+	if err != nil {
+		r.log.Errorf("[ListDraws] fail: %v", err)
+		return nil, errors.UnknownError
+	}
+	return draws, nil
+}
+
+func (r *PlayerRepo) CountDraws(ctx context.Context, prizeIDs []int64) (map[int64]int64, error) {
+	out := make(map[int64]int64, len(prizeIDs))
+	if len(prizeIDs) == 0 {
+		return out, nil
+	}
+	var rows []struct {
+		PrizeID int64
+		Total   int64
+	}
+	err := r.data.DB(ctx).Model(&model.Draw{}).
+		Select("prize_id, count(*) AS total").
+		Where("prize_id IN ?", prizeIDs).
+		Group("prize_id").
+		Scan(&rows).Error
+	if err != nil {
+		r.log.Errorf("[CountDraws] fail: %v", err)
+		return nil, errors.UnknownError
+	}
+	for _, row := range rows {
+		out[row.PrizeID] = row.Total
+	}
+	return out, nil
+}
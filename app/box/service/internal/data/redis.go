@@ -0,0 +1,29 @@
+package data
+
+import (
+	"casso/app/box/service/internal/conf"
+	"casso/pkg/metrics"
+	"casso/pkg/tracing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func NewRd(conf *conf.Data, mp *metrics.Prometheus, tp *tracesdk.TracerProvider, logger log.Logger) *redis.Client {
+	opts := redis.Options{
+		Addr:         conf.Redis.Addr,
+		Username:     conf.Redis.Auth,
+		Password:     conf.Redis.Password,
+		ReadTimeout:  conf.Redis.ReadTimeout.AsDuration(),
+		WriteTimeout: conf.Redis.WriteTimeout.AsDuration(),
+		PoolSize:     int(conf.Redis.Pool),
+		DB:           0,
+	}
+	rd := redis.NewClient(&opts)
+	if err := mp.Redis(rd, "default"); err != nil {
+		log.NewHelper(log.With(logger, "module", "box-service/data/redis")).Errorf("failed registering redis metrics: %v", err)
+	}
+	tracing.Redis(rd, tp)
+	return rd
+}
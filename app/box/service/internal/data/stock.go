@@ -0,0 +1,43 @@
+package data
+
+import (
+	"casso/app/box/service/internal/biz"
+	"casso/app/box/service/internal/conf"
+	"casso/pkg/util/mystery"
+	"context"
+	"fmt"
+)
+
+const defaultStockPrefix = "box:stock:"
+
+var _ biz.StockRepo = (*StockRepo)(nil)
+
+// StockRepo 奖池库存存放在 redis hash {prefix}{campaignID}:{version}
+type StockRepo struct {
+	data   *Data
+	prefix string
+}
+
+func NewStockRepo(data *Data, c *conf.Box) biz.StockRepo {
+	prefix := c.GetStockPrefix()
+	if prefix == "" {
+		prefix = defaultStockPrefix
+	}
+	return &StockRepo{data: data, prefix: prefix}
+}
+
+func (r *StockRepo) stock(campaignID, version int64) *mystery.RedisStock {
+	return mystery.NewRedisStock(r.data.rd, fmt.Sprintf("%s%d:%d", r.prefix, campaignID, version))
+}
+
+func (r *StockRepo) Stock(campaignID, version int64) mystery.Stock {
+	return r.stock(campaignID, version)
+}
+
+func (r *StockRepo) Init(ctx context.Context, campaignID, version int64, prizes []mystery.Prize) error {
+	return r.stock(campaignID, version).Init(ctx, prizes)
+}
+
+func (r *StockRepo) Remaining(ctx context.Context, campaignID, version int64) (map[int64]int64, error) {
+	return r.stock(campaignID, version).Remaining(ctx)
+}
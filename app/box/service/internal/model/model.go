@@ -0,0 +1,117 @@
+package model
+
+// box model
+
+// 活动状态，与 api CampaignStatus 一致
+const (
+	CampaignDraft int32 = iota
+	CampaignActive
+	CampaignPaused
+	CampaignClosed
+)
+
+// Campaign 盲盒活动，奖池每次修改 PoolVersion 加一，只有当前版本的奖品参与抽奖
+type Campaign struct {
+	ID          int64  `gorm:"primarykey"`
+	Name        string `gorm:"size:64;COMMENT:活动名称"`
+	Description string `gorm:"size:512;COMMENT:活动描述"`
+	Price       int64  `gorm:"COMMENT:单价，最小货币单位"`
+	Currency    string `gorm:"size:3;COMMENT:币种"`
+	Status      int32  `gorm:"COMMENT:状态 0草稿 1进行中 2暂停 3结束"`
+	StartTime   int64  `gorm:"COMMENT:开始时间，毫秒，0 不限"`
+	EndTime     int64  `gorm:"COMMENT:结束时间，毫秒，0 不限"`
+	MaxOpen     int32  `gorm:"COMMENT:单次最多开盒数量"`
+	PoolVersion int64  `gorm:"COMMENT:奖池版本"`
+	PityRules   string `gorm:"type:text;COMMENT:保底规则 JSON"`
+
+	UpdatedTime int64 `gorm:"autoUpdateTime:milli;COMMENT:最后修改时间"`
+	CreatedTime int64 `gorm:"autoCreateTime:milli;COMMENT:创建时间"`
+}
+
+func (c *Campaign) TableName() string {
+	return "box_campaign"
+}
+
+// PityRule 保底规则，以 JSON 存放在 Campaign.PityRules
+type PityRule struct {
+	Name      string  `json:"name"`
+	PrizeIDs  []int64 `json:"prize_ids"`
+	Ceiling   int     `json:"ceiling"`
+	SoftStart int     `json:"soft_start"`
+	SoftStep  int     `json:"soft_step"`
+}
+
+// Prize 奖品，历史版本的奖品保留用于展示抽奖记录
+type Prize struct {
+	ID          int64  `gorm:"primarykey"`
+	CampaignID  int64  `gorm:"index:idx_campaign_version;COMMENT:活动ID"`
+	PoolVersion int64  `gorm:"index:idx_campaign_version;COMMENT:奖池版本"`
+	Name        string `gorm:"size:64;COMMENT:奖品名称"`
+	Image       string `gorm:"size:255;COMMENT:奖品图片"`
+	Weight      int32  `gorm:"COMMENT:抽取权重"`
+	Stock       int64  `gorm:"COMMENT:初始库存，-1 不限量"`
+
+	CreatedTime int64 `gorm:"autoCreateTime:milli;COMMENT:创建时间"`
+}
+
+func (p *Prize) TableName() string {
+	return "box_prize"
+}
+
+// Player 玩家在活动中的抽奖状态：保底计数与可验证公平的种子，开盒时加行锁
+type Player struct {
+	ID         int64  `gorm:"primarykey"`
+	CampaignID int64  `gorm:"uniqueIndex:uk_campaign_user;COMMENT:活动ID"`
+	UserID     int64  `gorm:"uniqueIndex:uk_campaign_user;COMMENT:用户ID"`
+	Pity       string `gorm:"type:text;COMMENT:保底计数 JSON"`
+	ServerSeed string `gorm:"size:64;COMMENT:服务端种子，十六进制，轮换前不公开"`
+	Commitment string `gorm:"size:64;COMMENT:服务端种子承诺"`
+	ClientSeed string `gorm:"size:64;COMMENT:玩家种子"`
+	Nonce      uint64 `gorm:"COMMENT:下一次抽奖序号"`
+
+	UpdatedTime int64 `gorm:"autoUpdateTime:milli;COMMENT:最后修改时间"`
+	CreatedTime int64 `gorm:"autoCreateTime:milli;COMMENT:创建时间"`
+}
+
+func (p *Player) TableName() string {
+	return "box_player"
+}
+
+// Order 开盒订单，(UserID, RequestID) 唯一保证重复提交只开一次
+type Order struct {
+	ID         int64  `gorm:"primarykey"`
+	UserID     int64  `gorm:"uniqueIndex:uk_user_request;COMMENT:用户ID"`
+	RequestID  string `gorm:"uniqueIndex:uk_user_request;size:64;COMMENT:幂等键"`
+	CampaignID int64  `gorm:"COMMENT:活动ID"`
+	Count      int32  `gorm:"COMMENT:开盒数量"`
+	Amount     int64  `gorm:"COMMENT:金额，最小货币单位"`
+	Currency   string `gorm:"size:3;COMMENT:币种"`
+
+	CreatedTime int64 `gorm:"autoCreateTime:milli;COMMENT:创建时间"`
+}
+
+func (o *Order) TableName() string {
+	return "box_order"
+}
+
+// Draw 抽奖记录，保存重放校验所需的随机数、售罄排除与抽奖前的保底计数
+type Draw struct {
+	ID         int64  `gorm:"primarykey"`
+	OrderID    int64  `gorm:"index;COMMENT:订单ID"`
+	UserID     int64  `gorm:"index:idx_user_campaign;COMMENT:用户ID"`
+	CampaignID int64  `gorm:"index:idx_user_campaign;COMMENT:活动ID"`
+	PrizeID    int64  `gorm:"COMMENT:奖品ID"`
+	Nonce      uint64 `gorm:"COMMENT:抽奖序号"`
+	Roll       uint64 `gorm:"COMMENT:随机数"`
+	Pity       string `gorm:"size:64;COMMENT:触发硬保底的规则"`
+	SoldOut    string `gorm:"size:255;COMMENT:售罄排除的奖品 JSON"`
+	PityBefore string `gorm:"type:text;COMMENT:抽奖前的保底计数 JSON"`
+	Commitment string `gorm:"size:64;COMMENT:服务端种子承诺"`
+	ClientSeed string `gorm:"size:64;COMMENT:玩家种子"`
+
+	CreatedTime int64 `gorm:"autoCreateTime:milli;COMMENT:创建时间"`
+}
+
+func (d *Draw) TableName() string {
+	return "box_draw"
+}
@@ -0,0 +1,39 @@
+package server
+
+import (
+	v1 "casso/api/box/service/v1"
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// adminOperations 修改活动、奖池与库存的管理接口
+var adminOperations = []string{
+	"/api.box.service.v1.Box/CreateCampaign",
+	"/api.box.service.v1.Box/UpdateCampaign",
+	"/api.box.service.v1.Box/SetPool",
+}
+
+// AdminAuth 校验管理接口 metadata 中的 authorization: Bearer <token>，token 为空时拒绝所有请求
+func AdminAuth(token string) middleware.Middleware {
+	return selector.Server(func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if token == "" {
+				return nil, v1.ErrorBoxUnauthorized("admin api is disabled")
+			}
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, v1.ErrorBoxUnauthorized("invalid admin token")
+			}
+			got := strings.TrimPrefix(tr.RequestHeader().Get("authorization"), "Bearer ")
+			if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+				return nil, v1.ErrorBoxUnauthorized("invalid admin token")
+			}
+			return handler(ctx, req)
+		}
+	}).Path(adminOperations...).Build()
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	v1 "casso/api/box/service/v1"
+	"casso/app/box/service/internal/conf"
+	"casso/app/box/service/internal/service"
+	"casso/pkg/health"
+	"casso/pkg/metrics"
+	"casso/pkg/util/contextkey"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/go-kratos/kratos/v2/middleware/logging"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+)
+
+// NewGRPCServer new a gRPC server.
+func NewGRPCServer(c *conf.Server, logger log.Logger, tp *tracesdk.TracerProvider, s *service.BoxService, h *health.Health, mp *metrics.Prometheus) *grpc.Server {
+	var opts = []grpc.ServerOption{
+		grpc.Middleware(
+			recovery.Recovery(),
+			tracing.Server(
+				tracing.WithTracerProvider(tp)),
+			logging.Server(logger),
+			mp.Server(),
+			// 读取上游传递的登录用户、语言、请求ID等
+			contextkey.Server(),
+			// 管理接口需要携带 server.admin.token
+			AdminAuth(c.GetAdmin().GetToken()),
+		),
+		// 依赖未就绪时健康检查返回 NOT_SERVING
+		grpc.UnaryInterceptor(h.UnaryInterceptor()),
+	}
+	if c.Grpc.Network != "" {
+		opts = append(opts, grpc.Network(c.Grpc.Network))
+	}
+	if c.Grpc.Addr != "" {
+		opts = append(opts, grpc.Address(c.Grpc.Addr))
+	}
+	if c.Grpc.Timeout != nil {
+		opts = append(opts, grpc.Timeout(c.Grpc.Timeout.AsDuration()))
+	}
+	srv := grpc.NewServer(opts...)
+	v1.RegisterBoxServer(srv, s)
+	return srv
+}
@@ -0,0 +1,18 @@
+package server
+
+import (
+	"casso/app/box/service/internal/conf"
+	"casso/pkg/registry"
+
+	"github.com/google/wire"
+
+	kr "github.com/go-kratos/kratos/v2/registry"
+)
+
+// ProviderSet is server providers.
+var ProviderSet = wire.NewSet(NewRegistrar, NewGRPCServer, NewHTTPServer)
+
+// NewRegistrar 按 conf.Registry 的 backend 创建服务注册，默认 nacos
+func NewRegistrar(c *conf.Registry) (kr.Registrar, func(), error) {
+	return registry.NewRegistrar(c)
+}
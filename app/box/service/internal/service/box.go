@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+
+	pb "casso/api/box/service/v1"
+)
+
+func (s *BoxService) ListCampaigns(ctx context.Context, req *pb.ListCampaignsRequest) (*pb.ListCampaignsReply, error) {
+	// 数据校验
+	if req.Page <= 0 || req.Limit <= 0 {
+		return &pb.ListCampaignsReply{}, pb.ErrorBoxInvalidParams("invalid params")
+	}
+	// 调用业务用例
+	return s.uc.ListCampaigns(ctx, req)
+}
+
+func (s *BoxService) GetCampaign(ctx context.Context, req *pb.GetCampaignRequest) (*pb.GetCampaignReply, error) {
+	// 数据校验
+	if req.Id <= 0 {
+		return &pb.GetCampaignReply{}, pb.ErrorBoxInvalidParams("invalid params")
+	}
+	// 调用业务用例
+	return s.uc.GetCampaign(ctx, req.Id)
+}
+
+func (s *BoxService) OpenBox(ctx context.Context, req *pb.OpenBoxRequest) (*pb.OpenBoxReply, error) {
+	// 数据校验
+	if req.UserId <= 0 || req.CampaignId <= 0 || req.Count <= 0 || req.RequestId == "" || len(req.RequestId) > 64 {
+		return &pb.OpenBoxReply{}, pb.ErrorBoxInvalidParams("invalid params")
+	}
+	// 调用业务用例
+	return s.uc.OpenBox(ctx, req)
+}
+
+func (s *BoxService) ListDraws(ctx context.Context, req *pb.ListDrawsRequest) (*pb.ListDrawsReply, error) {
+	// 数据校验
+	if req.UserId <= 0 || req.CampaignId < 0 || req.Page <= 0 || req.Limit <= 0 {
+		return &pb.ListDrawsReply{}, pb.ErrorBoxInvalidParams("invalid params")
+	}
+	// 调用业务用例
+	return s.uc.ListDraws(ctx, req)
+}
+
+func (s *BoxService) GetSeed(ctx context.Context, req *pb.GetSeedRequest) (*pb.GetSeedReply, error) {
+	// 数据校验
+	if req.UserId <= 0 || req.CampaignId <= 0 {
+		return &pb.GetSeedReply{}, pb.ErrorBoxInvalidParams("invalid params")
+	}
+	// 调用业务用例
+	return s.uc.GetSeed(ctx, req.UserId, req.CampaignId)
+}
+
+func (s *BoxService) RotateSeed(ctx context.Context, req *pb.RotateSeedRequest) (*pb.RotateSeedReply, error) {
+	// 数据校验
+	if req.UserId <= 0 || req.CampaignId <= 0 || len(req.ClientSeed) > 64 {
+		return &pb.RotateSeedReply{}, pb.ErrorBoxInvalidParams("invalid params")
+	}
+	// 调用业务用例
+	return s.uc.RotateSeed(ctx, req)
+}
+
+func (s *BoxService) CreateCampaign(ctx context.Context, req *pb.CreateCampaignRequest) (*pb.CreateCampaignReply, error) {
+	// 数据校验
+	if req.Name == "" {
+		return &pb.CreateCampaignReply{}, pb.ErrorBoxInvalidParams("invalid params")
+	}
+	// 调用业务用例
+	return s.uc.CreateCampaign(ctx, req)
+}
+
+func (s *BoxService) UpdateCampaign(ctx context.Context, req *pb.UpdateCampaignRequest) (*pb.UpdateCampaignReply, error) {
+	// 数据校验
+	if req.Id <= 0 || req.Name == "" {
+		return &pb.UpdateCampaignReply{}, pb.ErrorBoxInvalidParams("invalid params")
+	}
+	// 调用业务用例
+	return s.uc.UpdateCampaign(ctx, req)
+}
+
+func (s *BoxService) SetPool(ctx context.Context, req *pb.SetPoolRequest) (*pb.SetPoolReply, error) {
+	// 数据校验
+	if req.CampaignId <= 0 || len(req.Prizes) == 0 {
+		return &pb.SetPoolReply{}, pb.ErrorBoxInvalidParams("invalid params")
+	}
+	// 调用业务用例
+	return s.uc.SetPool(ctx, req)
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	v1 "casso/api/box/service/v1"
+	"casso/app/box/service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+// ProviderSet is service providers.
+var ProviderSet = wire.NewSet(NewBoxService)
+
+type BoxService struct {
+	v1.UnimplementedBoxServer
+
+	uc  *biz.BoxUseCase
+	log *log.Helper
+}
+
+func NewBoxService(uc *biz.BoxUseCase, logger log.Logger) *BoxService {
+	return &BoxService{
+		uc:  uc,
+		log: log.NewHelper(log.With(logger, "module", "service/box"))}
+}
@@ -10,6 +10,7 @@ import (
 	"casso/app/shop/service/internal/data"
 	"casso/app/shop/service/internal/server"
 	"casso/app/shop/service/internal/service"
+	"casso/pkg/metrics"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
@@ -18,6 +19,6 @@ import (
 )
 
 // initApp init kratos application.
-func initApp(*conf.Server, *conf.Discovery, *conf.Data, log.Logger, *tracesdk.TracerProvider) (*kratos.App, func(), error) {
+func initApp(*conf.Server, *conf.Discovery, *conf.Data, *conf.Client, log.Logger, *tracesdk.TracerProvider, *metrics.Prometheus) (*kratos.App, func(), error) {
 	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, newApp))
 }
@@ -12,6 +12,8 @@ import (
 	"casso/app/shop/service/internal/data"
 	"casso/app/shop/service/internal/server"
 	"casso/app/shop/service/internal/service"
+	"casso/pkg/i18n"
+	"casso/pkg/metrics"
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
 	"go.opentelemetry.io/otel/sdk/trace"
@@ -20,19 +22,44 @@ import (
 // Injectors from wire.go:
 
 // initApp init kratos application.
-func initApp(confServer *conf.Server, discovery *conf.Discovery, confData *conf.Data, logger log.Logger, tracerProvider *trace.TracerProvider) (*kratos.App, func(), error) {
-	dataData, err := data.NewData(confData, logger)
+func initApp(confServer *conf.Server, discovery *conf.Discovery, confData *conf.Data, client *conf.Client, logger log.Logger, tracerProvider *trace.TracerProvider, prometheus *metrics.Prometheus) (*kratos.App, func(), error) {
+	client2 := data.NewRd(confData, prometheus, tracerProvider, logger)
+	dataData, cleanup, err := data.NewData(client2, logger)
 	if err != nil {
 		return nil, nil, err
 	}
 	shopRepo := data.NewShopRepo(dataData, logger)
-	registryDiscovery := server.NewDiscovery(discovery)
-	userClient := server.NewUserServiceClient(registryDiscovery)
-	shopUseCase := biz.NewShopUseCase(shopRepo, logger, userClient)
+	health := data.NewHealth(confData, dataData, logger)
+	registryDiscovery, cleanup2, err := server.NewDiscovery(discovery)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	userClient, cleanup3, err := server.NewUserServiceClient(client, registryDiscovery, health, prometheus, logger, tracerProvider)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	boxClient, cleanup4, err := server.NewBoxServiceClient(client, registryDiscovery, health, prometheus, logger, tracerProvider)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	shopUseCase := biz.NewShopUseCase(shopRepo, logger, userClient, boxClient)
 	shopService := service.NewShopService(shopUseCase, logger)
-	httpServer := server.NewHTTPServer(confServer, logger, tracerProvider, shopService)
-	grpcServer := server.NewGRPCServer(confServer, logger, tracerProvider, shopService)
-	app := newApp(logger, httpServer, grpcServer)
+	guard := data.NewIdempotentGuard(confData, dataData)
+	catalog := i18n.Default()
+	mapper := server.NewErrorMapper()
+	httpServer := server.NewHTTPServer(confServer, logger, tracerProvider, shopService, guard, catalog, mapper, health, prometheus)
+	grpcServer := server.NewGRPCServer(confServer, logger, tracerProvider, shopService, guard, catalog, mapper, health, prometheus)
+	app := newApp(logger, httpServer, grpcServer, health)
 	return app, func() {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
 	}, nil
 }
@@ -1,6 +1,7 @@
 package biz
 
 import (
+	bv1 "casso/api/box/service/v1"
 	v1 "casso/api/user/service/v1"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -15,8 +16,9 @@ type ShopUseCase struct {
 	log  *log.Helper
 
 	uc v1.UserClient
+	bc bv1.BoxClient
 }
 
-func NewShopUseCase(repo ShopRepo, logger log.Logger, uc v1.UserClient) *ShopUseCase {
-	return &ShopUseCase{repo: repo, log: log.NewHelper(log.With(logger, "module", "usecase/shop")), uc: uc}
+func NewShopUseCase(repo ShopRepo, logger log.Logger, uc v1.UserClient, bc bv1.BoxClient) *ShopUseCase {
+	return &ShopUseCase{repo: repo, log: log.NewHelper(log.With(logger, "module", "usecase/shop")), uc: uc, bc: bc}
 }
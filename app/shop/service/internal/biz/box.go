@@ -0,0 +1,94 @@
+package biz
+
+import (
+	bv1 "casso/api/box/service/v1"
+	pb "casso/api/shop/service/v1"
+	"context"
+)
+
+// ********* 盲盒：转发到 box 服务，用户ID由登录态决定 ***********
+
+func (s *ShopUseCase) ListBoxCampaigns(ctx context.Context, req *pb.ListBoxCampaignsRequest) (*pb.ListBoxCampaignsReply, error) {
+	res, err := s.bc.ListCampaigns(ctx, &bv1.ListCampaignsRequest{Page: req.Page, Limit: req.Limit})
+	if err != nil {
+		return &pb.ListBoxCampaignsReply{}, err
+	}
+	return &pb.ListBoxCampaignsReply{Campaigns: res.Campaigns}, nil
+}
+
+func (s *ShopUseCase) GetBoxCampaign(ctx context.Context, id int64) (*pb.GetBoxCampaignReply, error) {
+	res, err := s.bc.GetCampaign(ctx, &bv1.GetCampaignRequest{Id: id})
+	if err != nil {
+		return &pb.GetBoxCampaignReply{}, err
+	}
+	// 草稿中的活动不对外展示
+	if res.Campaign.GetStatus() == bv1.CampaignStatus_CAMPAIGN_DRAFT {
+		return &pb.GetBoxCampaignReply{}, bv1.ErrorBoxCampaignNotFound("campaign %d not found", id)
+	}
+	return &pb.GetBoxCampaignReply{Campaign: res.Campaign}, nil
+}
+
+func (s *ShopUseCase) OpenBox(ctx context.Context, userID int64, req *pb.OpenBoxRequest) (*pb.OpenBoxReply, error) {
+	res, err := s.bc.OpenBox(ctx, &bv1.OpenBoxRequest{
+		UserId:     userID,
+		CampaignId: req.CampaignId,
+		Count:      req.Count,
+		RequestId:  req.RequestId,
+	})
+	if err != nil {
+		return &pb.OpenBoxReply{}, err
+	}
+	return &pb.OpenBoxReply{
+		OrderId:  res.OrderId,
+		Amount:   res.Amount,
+		Draws:    res.Draws,
+		Replayed: res.Replayed,
+	}, nil
+}
+
+func (s *ShopUseCase) ListBoxDraws(ctx context.Context, userID int64, req *pb.ListBoxDrawsRequest) (*pb.ListBoxDrawsReply, error) {
+	res, err := s.bc.ListDraws(ctx, &bv1.ListDrawsRequest{
+		UserId:     userID,
+		CampaignId: req.CampaignId,
+		Page:       req.Page,
+		Limit:      req.Limit,
+	})
+	if err != nil {
+		return &pb.ListBoxDrawsReply{}, err
+	}
+	return &pb.ListBoxDrawsReply{Draws: res.Draws}, nil
+}
+
+func (s *ShopUseCase) GetBoxSeed(ctx context.Context, userID, campaignID int64) (*pb.GetBoxSeedReply, error) {
+	res, err := s.bc.GetSeed(ctx, &bv1.GetSeedRequest{UserId: userID, CampaignId: campaignID})
+	if err != nil {
+		return &pb.GetBoxSeedReply{}, err
+	}
+	return seedReply(res), nil
+}
+
+func (s *ShopUseCase) RotateBoxSeed(ctx context.Context, userID int64, req *pb.RotateBoxSeedRequest) (*pb.RotateBoxSeedReply, error) {
+	res, err := s.bc.RotateSeed(ctx, &bv1.RotateSeedRequest{
+		UserId:     userID,
+		CampaignId: req.CampaignId,
+		ClientSeed: req.ClientSeed,
+	})
+	if err != nil {
+		return &pb.RotateBoxSeedReply{}, err
+	}
+	return &pb.RotateBoxSeedReply{
+		ServerSeed: res.ServerSeed,
+		Commitment: res.Commitment,
+		ClientSeed: res.ClientSeed,
+		Nonce:      res.Nonce,
+		Next:       seedReply(res.Next),
+	}, nil
+}
+
+func seedReply(res *bv1.GetSeedReply) *pb.GetBoxSeedReply {
+	return &pb.GetBoxSeedReply{
+		Commitment: res.GetCommitment(),
+		ClientSeed: res.GetClientSeed(),
+		Nonce:      res.GetNonce(),
+	}
+}
@@ -3,22 +3,32 @@ package biz
 import (
 	pb "casso/api/shop/service/v1"
 	v1 "casso/api/user/service/v1"
+	"casso/pkg/util/mapper"
 	"context"
 )
 
+// userMapper 用户服务与 BFF 消息之间的映射，BFF 消息中新增的字段没有来源时直接报错
+var userMapper = mapper.New(
+	mapper.Strict(),
+	mapper.WithRename(&v1.GetUserReply{}, &pb.GetUserReply{}, map[string]string{"NickName": "Name"}),
+)
+
 func (s *ShopUseCase) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterReply, error) {
 	// 业务组装
-	res, err := s.uc.CreateUser(ctx, &v1.CreateUserRequest{
-		NickName: req.NickName,
-	})
-	if err != nil || !v1.IsUserRecordNotFound(err) {
+	in := &v1.CreateUserRequest{}
+	if err := userMapper.Map(in, req); err != nil {
+		return &pb.RegisterReply{}, err
+	}
+	res, err := s.uc.CreateUser(ctx, in)
+	if err != nil {
 		return &pb.RegisterReply{}, err
 	}
 
-	return &pb.RegisterReply{
-		Id:       res.Id,
-		NickName: res.NickName,
-	}, nil
+	reply := &pb.RegisterReply{}
+	if err := userMapper.Map(reply, res); err != nil {
+		return reply, err
+	}
+	return reply, nil
 }
 
 func (s *ShopUseCase) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginReply, error) {
@@ -45,7 +55,9 @@ func (s *ShopUseCase) GetUser(ctx context.Context, id int64) (*pb.GetUserReply,
 		return &pb.GetUserReply{}, err
 	}
 
-	return &pb.GetUserReply{
-		Name: res.NickName,
-	}, nil
+	reply := &pb.GetUserReply{}
+	if err := userMapper.Map(reply, res); err != nil {
+		return reply, err
+	}
+	return reply, nil
 }
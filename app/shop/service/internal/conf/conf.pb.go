@@ -7,6 +7,10 @@
 package conf
 
 import (
+	config "casso/pkg/config"
+	logging "casso/pkg/logging"
+	registry "casso/pkg/registry"
+	tracing "casso/pkg/tracing"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
@@ -26,9 +30,13 @@ type Bootstrap struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Trace  *Trace  `protobuf:"bytes,1,opt,name=trace,proto3" json:"trace,omitempty"`
-	Server *Server `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
-	Data   *Data   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Trace  *tracing.Config `protobuf:"bytes,1,opt,name=trace,proto3" json:"trace,omitempty"`
+	Server *Server         `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	Data   *Data           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Client *Client         `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
+	Log    *logging.Config `protobuf:"bytes,5,opt,name=log,proto3" json:"log,omitempty"`
+	Config *config.Remote  `protobuf:"bytes,6,opt,name=config,proto3" json:"config,omitempty"` // 远程配置源，只在启动时读取
+	Secret *config.Secret  `protobuf:"bytes,7,opt,name=secret,proto3" json:"secret,omitempty"` // 配置中 ${secret:name} 的来源，只在启动时读取
 }
 
 func (x *Bootstrap) Reset() {
@@ -63,7 +71,7 @@ func (*Bootstrap) Descriptor() ([]byte, []int) {
 	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Bootstrap) GetTrace() *Trace {
+func (x *Bootstrap) GetTrace() *tracing.Config {
 	if x != nil {
 		return x.Trace
 	}
@@ -84,51 +92,32 @@ func (x *Bootstrap) GetData() *Data {
 	return nil
 }
 
-type Trace struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-}
-
-func (x *Trace) Reset() {
-	*x = Trace{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Bootstrap) GetClient() *Client {
+	if x != nil {
+		return x.Client
 	}
+	return nil
 }
 
-func (x *Trace) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Trace) ProtoMessage() {}
-
-func (x *Trace) ProtoReflect() protoreflect.Message {
-	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Bootstrap) GetLog() *logging.Config {
+	if x != nil {
+		return x.Log
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Trace.ProtoReflect.Descriptor instead.
-func (*Trace) Descriptor() ([]byte, []int) {
-	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{1}
+func (x *Bootstrap) GetConfig() *config.Remote {
+	if x != nil {
+		return x.Config
+	}
+	return nil
 }
 
-func (x *Trace) GetEndpoint() string {
+func (x *Bootstrap) GetSecret() *config.Secret {
 	if x != nil {
-		return x.Endpoint
+		return x.Secret
 	}
-	return ""
+	return nil
 }
 
 type Server struct {
@@ -143,7 +132,7 @@ type Server struct {
 func (x *Server) Reset() {
 	*x = Server{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[2]
+		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -156,7 +145,7 @@ func (x *Server) String() string {
 func (*Server) ProtoMessage() {}
 
 func (x *Server) ProtoReflect() protoreflect.Message {
-	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[2]
+	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -169,7 +158,7 @@ func (x *Server) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Server.ProtoReflect.Descriptor instead.
 func (*Server) Descriptor() ([]byte, []int) {
-	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{2}
+	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Server) GetHttp() *Server_HTTP {
@@ -191,16 +180,16 @@ type Data struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Database *Data_Database `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis    *Data_Redis    `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
-	Apollo   *Data_Apollo   `protobuf:"bytes,3,opt,name=apollo,proto3" json:"apollo,omitempty"`
-	Kafka    *Data_Kafka    `protobuf:"bytes,4,opt,name=kafka,proto3" json:"kafka,omitempty"`
+	Database   *Data_Database   `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis      *Data_Redis      `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Kafka      *Data_Kafka      `protobuf:"bytes,4,opt,name=kafka,proto3" json:"kafka,omitempty"`
+	Idempotent *Data_Idempotent `protobuf:"bytes,5,opt,name=idempotent,proto3" json:"idempotent,omitempty"`
 }
 
 func (x *Data) Reset() {
 	*x = Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[3]
+		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -213,7 +202,7 @@ func (x *Data) String() string {
 func (*Data) ProtoMessage() {}
 
 func (x *Data) ProtoReflect() protoreflect.Message {
-	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[3]
+	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -226,7 +215,7 @@ func (x *Data) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data.ProtoReflect.Descriptor instead.
 func (*Data) Descriptor() ([]byte, []int) {
-	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{3}
+	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Data) GetDatabase() *Data_Database {
@@ -243,45 +232,48 @@ func (x *Data) GetRedis() *Data_Redis {
 	return nil
 }
 
-func (x *Data) GetApollo() *Data_Apollo {
+func (x *Data) GetKafka() *Data_Kafka {
 	if x != nil {
-		return x.Apollo
+		return x.Kafka
 	}
 	return nil
 }
 
-func (x *Data) GetKafka() *Data_Kafka {
+func (x *Data) GetIdempotent() *Data_Idempotent {
 	if x != nil {
-		return x.Kafka
+		return x.Idempotent
 	}
 	return nil
 }
 
-type Discovery struct {
+// Client 下游服务客户端，方法名可写完整 operation（/api.user.service.v1.User/GetUser）或只写 GetUser
+type Client struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nacos *Nacos `protobuf:"bytes,1,opt,name=nacos,proto3" json:"nacos,omitempty"`
+	User     *Client_Service `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Box      *Client_Service `protobuf:"bytes,2,opt,name=box,proto3" json:"box,omitempty"`
+	Balancer string          `protobuf:"bytes,3,opt,name=balancer,proto3" json:"balancer,omitempty"` // p2c | wrr，默认 p2c
 }
 
-func (x *Discovery) Reset() {
-	*x = Discovery{}
+func (x *Client) Reset() {
+	*x = Client{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[4]
+		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Discovery) String() string {
+func (x *Client) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Discovery) ProtoMessage() {}
+func (*Client) ProtoMessage() {}
 
-func (x *Discovery) ProtoReflect() protoreflect.Message {
-	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[4]
+func (x *Client) ProtoReflect() protoreflect.Message {
+	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -292,44 +284,61 @@ func (x *Discovery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Discovery.ProtoReflect.Descriptor instead.
-func (*Discovery) Descriptor() ([]byte, []int) {
-	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Client.ProtoReflect.Descriptor instead.
+func (*Client) Descriptor() ([]byte, []int) {
+	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Discovery) GetNacos() *Nacos {
+func (x *Client) GetUser() *Client_Service {
 	if x != nil {
-		return x.Nacos
+		return x.User
 	}
 	return nil
 }
 
-type Nacos struct {
+func (x *Client) GetBox() *Client_Service {
+	if x != nil {
+		return x.Box
+	}
+	return nil
+}
+
+func (x *Client) GetBalancer() string {
+	if x != nil {
+		return x.Balancer
+	}
+	return ""
+}
+
+type Discovery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Port    int64  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Backend string           `protobuf:"bytes,2,opt,name=backend,proto3" json:"backend,omitempty"` // nacos | etcd | consul | static | memory，默认 nacos
+	Nacos   *registry.Nacos  `protobuf:"bytes,1,opt,name=nacos,proto3" json:"nacos,omitempty"`
+	Etcd    *registry.Etcd   `protobuf:"bytes,3,opt,name=etcd,proto3" json:"etcd,omitempty"`
+	Consul  *registry.Consul `protobuf:"bytes,4,opt,name=consul,proto3" json:"consul,omitempty"`
+	Static  *registry.Static `protobuf:"bytes,5,opt,name=static,proto3" json:"static,omitempty"`
 }
 
-func (x *Nacos) Reset() {
-	*x = Nacos{}
+func (x *Discovery) Reset() {
+	*x = Discovery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[5]
+		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Nacos) String() string {
+func (x *Discovery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Nacos) ProtoMessage() {}
+func (*Discovery) ProtoMessage() {}
 
-func (x *Nacos) ProtoReflect() protoreflect.Message {
-	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[5]
+func (x *Discovery) ProtoReflect() protoreflect.Message {
+	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,23 +349,44 @@ func (x *Nacos) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Nacos.ProtoReflect.Descriptor instead.
-func (*Nacos) Descriptor() ([]byte, []int) {
-	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Discovery.ProtoReflect.Descriptor instead.
+func (*Discovery) Descriptor() ([]byte, []int) {
+	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Nacos) GetAddress() string {
+func (x *Discovery) GetBackend() string {
 	if x != nil {
-		return x.Address
+		return x.Backend
 	}
 	return ""
 }
 
-func (x *Nacos) GetPort() int64 {
+func (x *Discovery) GetNacos() *registry.Nacos {
 	if x != nil {
-		return x.Port
+		return x.Nacos
 	}
-	return 0
+	return nil
+}
+
+func (x *Discovery) GetEtcd() *registry.Etcd {
+	if x != nil {
+		return x.Etcd
+	}
+	return nil
+}
+
+func (x *Discovery) GetConsul() *registry.Consul {
+	if x != nil {
+		return x.Consul
+	}
+	return nil
+}
+
+func (x *Discovery) GetStatic() *registry.Static {
+	if x != nil {
+		return x.Static
+	}
+	return nil
 }
 
 type Server_HTTP struct {
@@ -372,7 +402,7 @@ type Server_HTTP struct {
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[6]
+		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -385,7 +415,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[6]
+	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,7 +428,7 @@ func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Server_HTTP.ProtoReflect.Descriptor instead.
 func (*Server_HTTP) Descriptor() ([]byte, []int) {
-	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{2, 0}
+	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{1, 0}
 }
 
 func (x *Server_HTTP) GetNetwork() string {
@@ -435,7 +465,7 @@ type Server_GRPC struct {
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[7]
+		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -448,7 +478,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[7]
+	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -461,7 +491,7 @@ func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Server_GRPC.ProtoReflect.Descriptor instead.
 func (*Server_GRPC) Descriptor() ([]byte, []int) {
-	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{2, 1}
+	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{1, 1}
 }
 
 func (x *Server_GRPC) GetNetwork() string {
@@ -497,7 +527,7 @@ type Data_Database struct {
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[8]
+		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -510,7 +540,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[8]
+	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -523,7 +553,7 @@ func (x *Data_Database) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data_Database.ProtoReflect.Descriptor instead.
 func (*Data_Database) Descriptor() ([]byte, []int) {
-	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{3, 0}
+	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{2, 0}
 }
 
 func (x *Data_Database) GetDriver() string {
@@ -557,7 +587,7 @@ type Data_Redis struct {
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[9]
+		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -570,7 +600,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[9]
+	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -583,7 +613,7 @@ func (x *Data_Redis) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data_Redis.ProtoReflect.Descriptor instead.
 func (*Data_Redis) Descriptor() ([]byte, []int) {
-	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{3, 1}
+	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{2, 1}
 }
 
 func (x *Data_Redis) GetNetwork() string {
@@ -635,35 +665,34 @@ func (x *Data_Redis) GetPassword() string {
 	return ""
 }
 
-type Data_Apollo struct {
+type Data_Kafka struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addr      string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	AppId     string   `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	Cluster   string   `protobuf:"bytes,3,opt,name=cluster,proto3" json:"cluster,omitempty"`
-	Namespace []string `protobuf:"bytes,4,rep,name=namespace,proto3" json:"namespace,omitempty"`
-	Screct    string   `protobuf:"bytes,5,opt,name=screct,proto3" json:"screct,omitempty"`
+	Addr        []string `protobuf:"bytes,1,rep,name=addr,proto3" json:"addr,omitempty"`
+	SendTopic   []string `protobuf:"bytes,2,rep,name=send_topic,json=sendTopic,proto3" json:"send_topic,omitempty"`
+	ReciveTopic []string `protobuf:"bytes,3,rep,name=recive_topic,json=reciveTopic,proto3" json:"recive_topic,omitempty"`
+	Group       []string `protobuf:"bytes,4,rep,name=group,proto3" json:"group,omitempty"`
 }
 
-func (x *Data_Apollo) Reset() {
-	*x = Data_Apollo{}
+func (x *Data_Kafka) Reset() {
+	*x = Data_Kafka{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[10]
+		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Data_Apollo) String() string {
+func (x *Data_Kafka) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data_Apollo) ProtoMessage() {}
+func (*Data_Kafka) ProtoMessage() {}
 
-func (x *Data_Apollo) ProtoReflect() protoreflect.Message {
-	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[10]
+func (x *Data_Kafka) ProtoReflect() protoreflect.Message {
+	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -674,59 +703,115 @@ func (x *Data_Apollo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data_Apollo.ProtoReflect.Descriptor instead.
-func (*Data_Apollo) Descriptor() ([]byte, []int) {
-	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{3, 2}
+// Deprecated: Use Data_Kafka.ProtoReflect.Descriptor instead.
+func (*Data_Kafka) Descriptor() ([]byte, []int) {
+	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{2, 2}
 }
 
-func (x *Data_Apollo) GetAddr() string {
+func (x *Data_Kafka) GetAddr() []string {
 	if x != nil {
 		return x.Addr
 	}
-	return ""
+	return nil
 }
 
-func (x *Data_Apollo) GetAppId() string {
+func (x *Data_Kafka) GetSendTopic() []string {
 	if x != nil {
-		return x.AppId
+		return x.SendTopic
 	}
-	return ""
+	return nil
 }
 
-func (x *Data_Apollo) GetCluster() string {
+func (x *Data_Kafka) GetReciveTopic() []string {
 	if x != nil {
-		return x.Cluster
+		return x.ReciveTopic
 	}
-	return ""
+	return nil
 }
 
-func (x *Data_Apollo) GetNamespace() []string {
+func (x *Data_Kafka) GetGroup() []string {
 	if x != nil {
-		return x.Namespace
+		return x.Group
 	}
 	return nil
 }
 
-func (x *Data_Apollo) GetScrect() string {
+type Data_Idempotent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Backend   string               `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"` // redis | memory
+	LockTtl   *durationpb.Duration `protobuf:"bytes,2,opt,name=lock_ttl,json=lockTtl,proto3" json:"lock_ttl,omitempty"`
+	Retention *durationpb.Duration `protobuf:"bytes,3,opt,name=retention,proto3" json:"retention,omitempty"`
+}
+
+func (x *Data_Idempotent) Reset() {
+	*x = Data_Idempotent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Data_Idempotent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Idempotent) ProtoMessage() {}
+
+func (x *Data_Idempotent) ProtoReflect() protoreflect.Message {
+	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Idempotent.ProtoReflect.Descriptor instead.
+func (*Data_Idempotent) Descriptor() ([]byte, []int) {
+	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *Data_Idempotent) GetBackend() string {
 	if x != nil {
-		return x.Screct
+		return x.Backend
 	}
 	return ""
 }
 
-type Data_Kafka struct {
+func (x *Data_Idempotent) GetLockTtl() *durationpb.Duration {
+	if x != nil {
+		return x.LockTtl
+	}
+	return nil
+}
+
+func (x *Data_Idempotent) GetRetention() *durationpb.Duration {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+type Client_Retry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addr        []string `protobuf:"bytes,1,rep,name=addr,proto3" json:"addr,omitempty"`
-	SendTopic   []string `protobuf:"bytes,2,rep,name=send_topic,json=sendTopic,proto3" json:"send_topic,omitempty"`
-	ReciveTopic []string `protobuf:"bytes,3,rep,name=recive_topic,json=reciveTopic,proto3" json:"recive_topic,omitempty"`
-	Group       []string `protobuf:"bytes,4,rep,name=group,proto3" json:"group,omitempty"`
+	Attempts   int32                `protobuf:"varint,1,opt,name=attempts,proto3" json:"attempts,omitempty"` // 最大尝试次数（含首次），不大于 1 时不重试
+	Backoff    *durationpb.Duration `protobuf:"bytes,2,opt,name=backoff,proto3" json:"backoff,omitempty"`    // 首次重试前的等待时间，之后按 2 倍递增
+	MaxBackoff *durationpb.Duration `protobuf:"bytes,3,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`
+	Methods    []string             `protobuf:"bytes,4,rep,name=methods,proto3" json:"methods,omitempty"` // 只重试幂等方法
 }
 
-func (x *Data_Kafka) Reset() {
-	*x = Data_Kafka{}
+func (x *Client_Retry) Reset() {
+	*x = Client_Retry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -734,13 +819,13 @@ func (x *Data_Kafka) Reset() {
 	}
 }
 
-func (x *Data_Kafka) String() string {
+func (x *Client_Retry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data_Kafka) ProtoMessage() {}
+func (*Client_Retry) ProtoMessage() {}
 
-func (x *Data_Kafka) ProtoReflect() protoreflect.Message {
+func (x *Client_Retry) ProtoReflect() protoreflect.Message {
 	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -752,35 +837,272 @@ func (x *Data_Kafka) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data_Kafka.ProtoReflect.Descriptor instead.
-func (*Data_Kafka) Descriptor() ([]byte, []int) {
+// Deprecated: Use Client_Retry.ProtoReflect.Descriptor instead.
+func (*Client_Retry) Descriptor() ([]byte, []int) {
+	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *Client_Retry) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Client_Retry) GetBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.Backoff
+	}
+	return nil
+}
+
+func (x *Client_Retry) GetMaxBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBackoff
+	}
+	return nil
+}
+
+func (x *Client_Retry) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+type Client_Breaker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Disabled bool                 `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Success  float64              `protobuf:"fixed64,2,opt,name=success,proto3" json:"success,omitempty"` // SRE 熔断的成功率阈值，默认 0.6
+	Request  int64                `protobuf:"varint,3,opt,name=request,proto3" json:"request,omitempty"`  // 窗口内请求数少于此值时不熔断，默认 100
+	Window   *durationpb.Duration `protobuf:"bytes,4,opt,name=window,proto3" json:"window,omitempty"`     // 统计窗口，默认 3s
+	Bucket   int32                `protobuf:"varint,5,opt,name=bucket,proto3" json:"bucket,omitempty"`    // 窗口分桶数，默认 10
+}
+
+func (x *Client_Breaker) Reset() {
+	*x = Client_Breaker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Client_Breaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Client_Breaker) ProtoMessage() {}
+
+func (x *Client_Breaker) ProtoReflect() protoreflect.Message {
+	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Client_Breaker.ProtoReflect.Descriptor instead.
+func (*Client_Breaker) Descriptor() ([]byte, []int) {
+	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *Client_Breaker) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Client_Breaker) GetSuccess() float64 {
+	if x != nil {
+		return x.Success
+	}
+	return 0
+}
+
+func (x *Client_Breaker) GetRequest() int64 {
+	if x != nil {
+		return x.Request
+	}
+	return 0
+}
+
+func (x *Client_Breaker) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *Client_Breaker) GetBucket() int32 {
+	if x != nil {
+		return x.Bucket
+	}
+	return 0
+}
+
+type Client_Fallback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Methods []string             `protobuf:"bytes,1,rep,name=methods,proto3" json:"methods,omitempty"` // 降级读方法：调用失败时返回缓存的上一次结果
+	Ttl     *durationpb.Duration `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Size    int32                `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *Client_Fallback) Reset() {
+	*x = Client_Fallback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Client_Fallback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Client_Fallback) ProtoMessage() {}
+
+func (x *Client_Fallback) ProtoReflect() protoreflect.Message {
+	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Client_Fallback.ProtoReflect.Descriptor instead.
+func (*Client_Fallback) Descriptor() ([]byte, []int) {
+	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{3, 2}
+}
+
+func (x *Client_Fallback) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+func (x *Client_Fallback) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *Client_Fallback) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type Client_Service struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Endpoint       string                          `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Timeout        *durationpb.Duration            `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`                                                                                                                             // 整体超时，包括重试
+	MethodTimeout  *durationpb.Duration            `protobuf:"bytes,3,opt,name=method_timeout,json=methodTimeout,proto3" json:"method_timeout,omitempty"`                                                                                            // 单次调用超时
+	MethodTimeouts map[string]*durationpb.Duration `protobuf:"bytes,4,rep,name=method_timeouts,json=methodTimeouts,proto3" json:"method_timeouts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 按方法覆盖单次调用超时
+	Retry          *Client_Retry                   `protobuf:"bytes,5,opt,name=retry,proto3" json:"retry,omitempty"`
+	Breaker        *Client_Breaker                 `protobuf:"bytes,6,opt,name=breaker,proto3" json:"breaker,omitempty"`
+	Fallback       *Client_Fallback                `protobuf:"bytes,7,opt,name=fallback,proto3" json:"fallback,omitempty"`
+}
+
+func (x *Client_Service) Reset() {
+	*x = Client_Service{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Client_Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Client_Service) ProtoMessage() {}
+
+func (x *Client_Service) ProtoReflect() protoreflect.Message {
+	mi := &file_app_shop_service_internal_conf_conf_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Client_Service.ProtoReflect.Descriptor instead.
+func (*Client_Service) Descriptor() ([]byte, []int) {
 	return file_app_shop_service_internal_conf_conf_proto_rawDescGZIP(), []int{3, 3}
 }
 
-func (x *Data_Kafka) GetAddr() []string {
+func (x *Client_Service) GetEndpoint() string {
 	if x != nil {
-		return x.Addr
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Client_Service) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
 	}
 	return nil
 }
 
-func (x *Data_Kafka) GetSendTopic() []string {
+func (x *Client_Service) GetMethodTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.SendTopic
+		return x.MethodTimeout
 	}
 	return nil
 }
 
-func (x *Data_Kafka) GetReciveTopic() []string {
+func (x *Client_Service) GetMethodTimeouts() map[string]*durationpb.Duration {
 	if x != nil {
-		return x.ReciveTopic
+		return x.MethodTimeouts
 	}
 	return nil
 }
 
-func (x *Data_Kafka) GetGroup() []string {
+func (x *Client_Service) GetRetry() *Client_Retry {
 	if x != nil {
-		return x.Group
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *Client_Service) GetBreaker() *Client_Breaker {
+	if x != nil {
+		return x.Breaker
+	}
+	return nil
+}
+
+func (x *Client_Service) GetFallback() *Client_Fallback {
+	if x != nil {
+		return x.Fallback
 	}
 	return nil
 }
@@ -793,49 +1115,65 @@ var file_app_shop_service_internal_conf_conf_proto_rawDesc = []byte{
 	0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x73, 0x68, 0x6f,
 	0x70, 0x2e, 0x61, 0x70, 0x69, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x01, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
-	0x72, 0x61, 0x70, 0x12, 0x25, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72,
-	0x61, 0x63, 0x65, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x65,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x70, 0x6b, 0x67, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x79, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x70, 0x6b, 0x67,
+	0x2f, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x2f, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x72, 0x61, 0x63,
+	0x69, 0x6e, 0x67, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xad, 0x02, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x12,
+	0x29, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x65,
 	0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x68, 0x6f,
 	0x70, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65,
 	0x72, 0x76, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x23, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x63,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0xb4, 0x02,
-	0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x52, 0x04, 0x68,
-	0x74, 0x74, 0x70, 0x12, 0x29, 0x0a, 0x04, 0x67, 0x72, 0x70, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x52, 0x04, 0x67, 0x72, 0x70, 0x63, 0x1a, 0x69,
-	0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x61, 0x64, 0x64, 0x72, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x69, 0x0a, 0x04, 0x47, 0x52, 0x50,
-	0x43, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x61,
-	0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12,
-	0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x22, 0xf3, 0x05, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x33, 0x0a,
-	0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x2e,
-	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12, 0x2a, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x22, 0xb4, 0x02, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x04,
+	0x68, 0x74, 0x74, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x68, 0x6f,
+	0x70, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54,
+	0x50, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x29, 0x0a, 0x04, 0x67, 0x72, 0x70, 0x63, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x52, 0x04, 0x67, 0x72,
+	0x70, 0x63, 0x1a, 0x69, 0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x69, 0x0a,
+	0x04, 0x47, 0x52, 0x50, 0x43, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61,
+	0x64, 0x64, 0x72, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x97, 0x06, 0x0a, 0x04, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x33, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x73, 0x52, 0x05, 0x72, 0x65, 0x64,
+	0x69, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x14, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61, 0x74,
-	0x61, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x73, 0x52, 0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x12, 0x2d,
-	0x0a, 0x06, 0x61, 0x70, 0x6f, 0x6c, 0x6c, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x41,
-	0x70, 0x6f, 0x6c, 0x6c, 0x6f, 0x52, 0x06, 0x61, 0x70, 0x6f, 0x6c, 0x6c, 0x6f, 0x12, 0x2a, 0x0a,
-	0x05, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73,
-	0x68, 0x6f, 0x70, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x4b, 0x61, 0x66,
-	0x6b, 0x61, 0x52, 0x05, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x1a, 0x3a, 0x0a, 0x08, 0x44, 0x61, 0x74,
+	0x61, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x52, 0x05, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x12, 0x39,
+	0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x2e, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x3a, 0x0a, 0x08, 0x44, 0x61, 0x74,
 	0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a,
 	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
@@ -855,32 +1193,107 @@ var file_app_shop_service_internal_conf_conf_proto_rawDesc = []byte{
 	0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61,
 	0x75, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
 	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x1a,
-	0x83, 0x01, 0x0a, 0x06, 0x41, 0x70, 0x6f, 0x6c, 0x6c, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64,
-	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x15,
-	0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12,
-	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x63, 0x72, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x63, 0x72, 0x65, 0x63, 0x74, 0x1a, 0x73, 0x0a, 0x05, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x12, 0x12,
-	0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64,
-	0x64, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x70, 0x69,
-	0x63, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x76, 0x65, 0x54,
-	0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x32, 0x0a, 0x09, 0x44, 0x69,
-	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x6e, 0x61, 0x63, 0x6f, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x4e, 0x61, 0x63, 0x6f, 0x73, 0x52, 0x05, 0x6e, 0x61, 0x63, 0x6f, 0x73, 0x22, 0x35,
-	0x0a, 0x05, 0x4e, 0x61, 0x63, 0x6f, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x04, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x2b, 0x5a, 0x29, 0x63, 0x61, 0x73, 0x73, 0x6f, 0x2f, 0x61,
-	0x70, 0x70, 0x2f, 0x73, 0x68, 0x6f, 0x70, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x3b, 0x63, 0x6f,
-	0x6e, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x0a, 0x05, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x63, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14,
+	0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x1a, 0x95, 0x01, 0x0a, 0x0a, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x34, 0x0a,
+	0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x74, 0x6c, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x03,
+	0x10, 0x04, 0x22, 0xaa, 0x08, 0x0a, 0x06, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x68,
+	0x6f, 0x70, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x03, 0x62,
+	0x6f, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x03, 0x62, 0x6f, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x72, 0x1a, 0xae, 0x01, 0x0a, 0x05, 0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x62, 0x61, 0x63,
+	0x6b, 0x6f, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x3a,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x6d, 0x61, 0x78, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x73, 0x1a, 0xa4, 0x01, 0x0a, 0x07, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x31, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x1a, 0x65, 0x0a, 0x08, 0x46,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x73, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x1a, 0xea, 0x03, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x40, 0x0a, 0x0e, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0d, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x55, 0x0a, 0x0f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x68, 0x6f,
+	0x70, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52,
+	0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x12, 0x32, 0x0a, 0x07, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x68, 0x6f, 0x70, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x52, 0x07, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x08, 0x66, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
+	0x68, 0x6f, 0x70, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x46,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x1a, 0x5c, 0x0a, 0x13, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xd4, 0x01, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x6e, 0x61, 0x63, 0x6f, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x4e, 0x61, 0x63, 0x6f, 0x73, 0x52, 0x05, 0x6e, 0x61, 0x63,
+	0x6f, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x65, 0x74, 0x63, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e,
+	0x45, 0x74, 0x63, 0x64, 0x52, 0x04, 0x65, 0x74, 0x63, 0x64, 0x12, 0x2c, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x6b, 0x67,
+	0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c,
+	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x42, 0x2b, 0x5a, 0x29, 0x63, 0x61, 0x73, 0x73, 0x6f, 0x2f,
+	0x61, 0x70, 0x70, 0x2f, 0x73, 0x68, 0x6f, 0x70, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x3b, 0x63,
+	0x6f, 0x6e, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -895,42 +1308,76 @@ func file_app_shop_service_internal_conf_conf_proto_rawDescGZIP() []byte {
 	return file_app_shop_service_internal_conf_conf_proto_rawDescData
 }
 
-var file_app_shop_service_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_app_shop_service_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_app_shop_service_internal_conf_conf_proto_goTypes = []interface{}{
 	(*Bootstrap)(nil),           // 0: shop.api.Bootstrap
-	(*Trace)(nil),               // 1: shop.api.Trace
-	(*Server)(nil),              // 2: shop.api.Server
-	(*Data)(nil),                // 3: shop.api.Data
+	(*Server)(nil),              // 1: shop.api.Server
+	(*Data)(nil),                // 2: shop.api.Data
+	(*Client)(nil),              // 3: shop.api.Client
 	(*Discovery)(nil),           // 4: shop.api.Discovery
-	(*Nacos)(nil),               // 5: shop.api.Nacos
-	(*Server_HTTP)(nil),         // 6: shop.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 7: shop.api.Server.GRPC
-	(*Data_Database)(nil),       // 8: shop.api.Data.Database
-	(*Data_Redis)(nil),          // 9: shop.api.Data.Redis
-	(*Data_Apollo)(nil),         // 10: shop.api.Data.Apollo
-	(*Data_Kafka)(nil),          // 11: shop.api.Data.Kafka
-	(*durationpb.Duration)(nil), // 12: google.protobuf.Duration
+	(*Server_HTTP)(nil),         // 5: shop.api.Server.HTTP
+	(*Server_GRPC)(nil),         // 6: shop.api.Server.GRPC
+	(*Data_Database)(nil),       // 7: shop.api.Data.Database
+	(*Data_Redis)(nil),          // 8: shop.api.Data.Redis
+	(*Data_Kafka)(nil),          // 9: shop.api.Data.Kafka
+	(*Data_Idempotent)(nil),     // 10: shop.api.Data.Idempotent
+	(*Client_Retry)(nil),        // 11: shop.api.Client.Retry
+	(*Client_Breaker)(nil),      // 12: shop.api.Client.Breaker
+	(*Client_Fallback)(nil),     // 13: shop.api.Client.Fallback
+	(*Client_Service)(nil),      // 14: shop.api.Client.Service
+	nil,                         // 15: shop.api.Client.Service.MethodTimeoutsEntry
+	(*tracing.Config)(nil),      // 16: pkg.tracing.Config
+	(*logging.Config)(nil),      // 17: pkg.logging.Config
+	(*config.Remote)(nil),       // 18: pkg.config.Remote
+	(*config.Secret)(nil),       // 19: pkg.config.Secret
+	(*registry.Nacos)(nil),      // 20: pkg.registry.Nacos
+	(*registry.Etcd)(nil),       // 21: pkg.registry.Etcd
+	(*registry.Consul)(nil),     // 22: pkg.registry.Consul
+	(*registry.Static)(nil),     // 23: pkg.registry.Static
+	(*durationpb.Duration)(nil), // 24: google.protobuf.Duration
 }
 var file_app_shop_service_internal_conf_conf_proto_depIdxs = []int32{
-	1,  // 0: shop.api.Bootstrap.trace:type_name -> shop.api.Trace
-	2,  // 1: shop.api.Bootstrap.server:type_name -> shop.api.Server
-	3,  // 2: shop.api.Bootstrap.data:type_name -> shop.api.Data
-	6,  // 3: shop.api.Server.http:type_name -> shop.api.Server.HTTP
-	7,  // 4: shop.api.Server.grpc:type_name -> shop.api.Server.GRPC
-	8,  // 5: shop.api.Data.database:type_name -> shop.api.Data.Database
-	9,  // 6: shop.api.Data.redis:type_name -> shop.api.Data.Redis
-	10, // 7: shop.api.Data.apollo:type_name -> shop.api.Data.Apollo
-	11, // 8: shop.api.Data.kafka:type_name -> shop.api.Data.Kafka
-	5,  // 9: shop.api.Discovery.nacos:type_name -> shop.api.Nacos
-	12, // 10: shop.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	12, // 11: shop.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	12, // 12: shop.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	12, // 13: shop.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	16, // 0: shop.api.Bootstrap.trace:type_name -> pkg.tracing.Config
+	1,  // 1: shop.api.Bootstrap.server:type_name -> shop.api.Server
+	2,  // 2: shop.api.Bootstrap.data:type_name -> shop.api.Data
+	3,  // 3: shop.api.Bootstrap.client:type_name -> shop.api.Client
+	17, // 4: shop.api.Bootstrap.log:type_name -> pkg.logging.Config
+	18, // 5: shop.api.Bootstrap.config:type_name -> pkg.config.Remote
+	19, // 6: shop.api.Bootstrap.secret:type_name -> pkg.config.Secret
+	5,  // 7: shop.api.Server.http:type_name -> shop.api.Server.HTTP
+	6,  // 8: shop.api.Server.grpc:type_name -> shop.api.Server.GRPC
+	7,  // 9: shop.api.Data.database:type_name -> shop.api.Data.Database
+	8,  // 10: shop.api.Data.redis:type_name -> shop.api.Data.Redis
+	9,  // 11: shop.api.Data.kafka:type_name -> shop.api.Data.Kafka
+	10, // 12: shop.api.Data.idempotent:type_name -> shop.api.Data.Idempotent
+	14, // 13: shop.api.Client.user:type_name -> shop.api.Client.Service
+	14, // 14: shop.api.Client.box:type_name -> shop.api.Client.Service
+	20, // 15: shop.api.Discovery.nacos:type_name -> pkg.registry.Nacos
+	21, // 16: shop.api.Discovery.etcd:type_name -> pkg.registry.Etcd
+	22, // 17: shop.api.Discovery.consul:type_name -> pkg.registry.Consul
+	23, // 18: shop.api.Discovery.static:type_name -> pkg.registry.Static
+	24, // 19: shop.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	24, // 20: shop.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	24, // 21: shop.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	24, // 22: shop.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	24, // 23: shop.api.Data.Idempotent.lock_ttl:type_name -> google.protobuf.Duration
+	24, // 24: shop.api.Data.Idempotent.retention:type_name -> google.protobuf.Duration
+	24, // 25: shop.api.Client.Retry.backoff:type_name -> google.protobuf.Duration
+	24, // 26: shop.api.Client.Retry.max_backoff:type_name -> google.protobuf.Duration
+	24, // 27: shop.api.Client.Breaker.window:type_name -> google.protobuf.Duration
+	24, // 28: shop.api.Client.Fallback.ttl:type_name -> google.protobuf.Duration
+	24, // 29: shop.api.Client.Service.timeout:type_name -> google.protobuf.Duration
+	24, // 30: shop.api.Client.Service.method_timeout:type_name -> google.protobuf.Duration
+	15, // 31: shop.api.Client.Service.method_timeouts:type_name -> shop.api.Client.Service.MethodTimeoutsEntry
+	11, // 32: shop.api.Client.Service.retry:type_name -> shop.api.Client.Retry
+	12, // 33: shop.api.Client.Service.breaker:type_name -> shop.api.Client.Breaker
+	13, // 34: shop.api.Client.Service.fallback:type_name -> shop.api.Client.Fallback
+	24, // 35: shop.api.Client.Service.MethodTimeoutsEntry.value:type_name -> google.protobuf.Duration
+	36, // [36:36] is the sub-list for method output_type
+	36, // [36:36] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_app_shop_service_internal_conf_conf_proto_init() }
@@ -952,7 +1399,7 @@ func file_app_shop_service_internal_conf_conf_proto_init() {
 			}
 		}
 		file_app_shop_service_internal_conf_conf_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Trace); i {
+			switch v := v.(*Server); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -964,7 +1411,7 @@ func file_app_shop_service_internal_conf_conf_proto_init() {
 			}
 		}
 		file_app_shop_service_internal_conf_conf_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Server); i {
+			switch v := v.(*Data); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -976,7 +1423,7 @@ func file_app_shop_service_internal_conf_conf_proto_init() {
 			}
 		}
 		file_app_shop_service_internal_conf_conf_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Data); i {
+			switch v := v.(*Client); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1000,7 +1447,7 @@ func file_app_shop_service_internal_conf_conf_proto_init() {
 			}
 		}
 		file_app_shop_service_internal_conf_conf_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Nacos); i {
+			switch v := v.(*Server_HTTP); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1012,7 +1459,7 @@ func file_app_shop_service_internal_conf_conf_proto_init() {
 			}
 		}
 		file_app_shop_service_internal_conf_conf_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Server_HTTP); i {
+			switch v := v.(*Server_GRPC); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1024,7 +1471,7 @@ func file_app_shop_service_internal_conf_conf_proto_init() {
 			}
 		}
 		file_app_shop_service_internal_conf_conf_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Server_GRPC); i {
+			switch v := v.(*Data_Database); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1036,7 +1483,7 @@ func file_app_shop_service_internal_conf_conf_proto_init() {
 			}
 		}
 		file_app_shop_service_internal_conf_conf_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Data_Database); i {
+			switch v := v.(*Data_Redis); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1048,7 +1495,7 @@ func file_app_shop_service_internal_conf_conf_proto_init() {
 			}
 		}
 		file_app_shop_service_internal_conf_conf_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Data_Redis); i {
+			switch v := v.(*Data_Kafka); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1060,7 +1507,7 @@ func file_app_shop_service_internal_conf_conf_proto_init() {
 			}
 		}
 		file_app_shop_service_internal_conf_conf_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Data_Apollo); i {
+			switch v := v.(*Data_Idempotent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1072,7 +1519,43 @@ func file_app_shop_service_internal_conf_conf_proto_init() {
 			}
 		}
 		file_app_shop_service_internal_conf_conf_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Data_Kafka); i {
+			switch v := v.(*Client_Retry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_shop_service_internal_conf_conf_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Client_Breaker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_shop_service_internal_conf_conf_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Client_Fallback); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_shop_service_internal_conf_conf_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Client_Service); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1090,7 +1573,7 @@ func file_app_shop_service_internal_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_app_shop_service_internal_conf_conf_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,38 @@
+package conf
+
+import (
+	"casso/pkg/balancer"
+	"casso/pkg/registry"
+	"errors"
+	"fmt"
+)
+
+// Validate 由 pkg/config 在加载、重新加载时调用，不合法的配置不生效
+func (x *Bootstrap) Validate() error {
+	if x.GetServer().GetHttp().GetAddr() == "" {
+		return errors.New("conf: server.http.addr is required")
+	}
+	if x.GetData().GetRedis().GetAddr() == "" {
+		return errors.New("conf: data.redis.addr is required")
+	}
+	switch b := x.GetClient().GetBalancer(); b {
+	case "", balancer.P2C, balancer.WRR:
+	default:
+		return fmt.Errorf("conf: unknown client.balancer %q", b)
+	}
+	if err := x.GetTrace().Validate(); err != nil {
+		return err
+	}
+	if err := x.GetConfig().Validate(); err != nil {
+		return err
+	}
+	if err := x.GetSecret().Validate(); err != nil {
+		return err
+	}
+	return x.GetLog().Validate()
+}
+
+// Validate discovery.yaml
+func (x *Discovery) Validate() error {
+	return registry.Validate(x)
+}
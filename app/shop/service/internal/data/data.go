@@ -2,24 +2,53 @@ package data
 
 import (
 	"casso/app/shop/service/internal/conf"
+	"casso/pkg/metrics"
+	"casso/pkg/tracing"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
 	"github.com/google/wire"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 
 	// init mysql driver
 	_ "github.com/go-sql-driver/mysql"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewShopRepo)
+var ProviderSet = wire.NewSet(NewData, NewRd, NewShopRepo, NewIdempotentGuard, NewHealth)
 
 // Data .
 type Data struct {
+	rd  *redis.Client
 	log *log.Helper
 }
 
 // NewData .
-func NewData(conf *conf.Data, logger log.Logger) (*Data, error) {
+func NewData(rd *redis.Client, logger log.Logger) (*Data, func(), error) {
 	l := log.NewHelper(log.With(logger, "module", "shop-admin/data"))
-	return &Data{log: l}, nil
+	d := &Data{rd: rd, log: l}
+	return d, func() {
+		if err := d.rd.Close(); err != nil {
+			l.Errorf("redis closing resource got fail: %v", err)
+		}
+		l.Info("resource close successed !")
+	}, nil
+}
+
+func NewRd(conf *conf.Data, mp *metrics.Prometheus, tp *tracesdk.TracerProvider, logger log.Logger) *redis.Client {
+	opts := redis.Options{
+		Addr:         conf.Redis.Addr,
+		Username:     conf.Redis.Auth,
+		Password:     conf.Redis.Password,
+		ReadTimeout:  conf.Redis.ReadTimeout.AsDuration(),
+		WriteTimeout: conf.Redis.WriteTimeout.AsDuration(),
+		PoolSize:     int(conf.Redis.Pool),
+		DB:           0,
+	}
+	rd := redis.NewClient(&opts)
+	if err := mp.Redis(rd, "default"); err != nil {
+		log.NewHelper(log.With(logger, "module", "shop-admin/data")).Errorf("failed registering redis metrics: %v", err)
+	}
+	tracing.Redis(rd, tp)
+	return rd
 }
@@ -0,0 +1,18 @@
+package data
+
+import (
+	"casso/app/shop/service/internal/conf"
+	"casso/pkg/health"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewHealth 就绪检查：redis 与 kafka，下游 gRPC 服务在创建客户端时注册
+func NewHealth(c *conf.Data, d *Data, logger log.Logger) *health.Health {
+	h := health.New(health.WithLogger(logger))
+	h.Register("redis", health.Redis(d.rd))
+	if addrs := c.GetKafka().GetAddr(); len(addrs) > 0 {
+		h.Register("kafka", health.TCP(addrs...))
+	}
+	return h
+}
@@ -0,0 +1,26 @@
+package data
+
+import (
+	"casso/app/shop/service/internal/conf"
+	"casso/pkg/idempotent"
+)
+
+// NewIdempotentGuard 幂等守卫，防止重试的注册请求、支付回调被重复执行
+func NewIdempotentGuard(conf *conf.Data, d *Data) *idempotent.Guard {
+	var store idempotent.Store
+	switch conf.Idempotent.GetBackend() {
+	case "memory":
+		store = idempotent.NewMemoryStore()
+	default:
+		store = idempotent.NewRedisStore(d.rd)
+	}
+
+	opts := []idempotent.Option{idempotent.WithPrefix("shop:idempotent:")}
+	if conf.Idempotent.GetLockTtl() != nil {
+		opts = append(opts, idempotent.WithLockTTL(conf.Idempotent.GetLockTtl().AsDuration()))
+	}
+	if conf.Idempotent.GetRetention() != nil {
+		opts = append(opts, idempotent.WithRetention(conf.Idempotent.GetRetention().AsDuration()))
+	}
+	return idempotent.New(store, opts...)
+}
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS `shop_log` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `order_sn` longtext NOT NULL COMMENT '订单号（不可使用order关键词，mysql查询会报错）',
+  `updated_time` bigint(20) DEFAULT NULL COMMENT '最后修改时间',
+  `created_time` bigint(20) DEFAULT NULL COMMENT '创建时间',
+  `delete_time` bigint(20) DEFAULT NULL COMMENT '删除时间',
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
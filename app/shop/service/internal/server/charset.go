@@ -0,0 +1,73 @@
+package server
+
+import (
+	"bytes"
+	"casso/pkg/errors"
+	"casso/pkg/util/str"
+	"io"
+	"io/ioutil"
+	"mime"
+	nethttp "net/http"
+	"net/url"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// maxBodySize 需要转换编码的请求体上限，转换时整个请求体会读入内存
+const maxBodySize = 4 << 20
+
+// RequestDecoder 合作方的旧系统以 GBK 等编码提交数据，Content-Type 声明了非 UTF-8 的 charset 时
+// 先将请求体转换为 UTF-8，再交给默认的解码器；未声明 charset 时按 UTF-8 处理
+func RequestDecoder(r *nethttp.Request, v interface{}) error {
+	contentType := r.Header.Get("Content-Type")
+	cs, err := str.CharsetFromContentType(contentType)
+	if err != nil {
+		return errors.InvalidParams
+	}
+	if cs == str.Unknown || cs == str.UTF8 {
+		return http.DefaultRequestDecoder(r, v)
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+	if err != nil || len(body) > maxBodySize {
+		return errors.InvalidParams
+	}
+	mediaType, params, _ := mime.ParseMediaType(contentType)
+	if mediaType == "application/x-www-form-urlencoded" {
+		// 表单中转义的是原始编码的字节，需要先解析再逐个转换
+		body, err = decodeForm(body, cs)
+	} else {
+		body, err = str.Decode(body, cs)
+	}
+	if err != nil {
+		return errors.InvalidParams
+	}
+
+	params["charset"] = string(str.UTF8)
+	r.Header.Set("Content-Type", mime.FormatMediaType(mediaType, params))
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+	return http.DefaultRequestDecoder(r, v)
+}
+
+func decodeForm(body []byte, cs str.Charset) ([]byte, error) {
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	out := make(url.Values, len(values))
+	for k, vs := range values {
+		key, err := str.Decode([]byte(k), cs)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range vs {
+			val, err := str.Decode([]byte(v), cs)
+			if err != nil {
+				return nil, err
+			}
+			out.Add(string(key), string(val))
+		}
+	}
+	return []byte(out.Encode()), nil
+}
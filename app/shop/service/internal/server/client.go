@@ -0,0 +1,104 @@
+package server
+
+import (
+	bv1 "casso/api/box/service/v1"
+	uv1 "casso/api/user/service/v1"
+	"casso/app/shop/service/internal/conf"
+	"casso/pkg/balancer"
+	"casso/pkg/health"
+	"casso/pkg/metrics"
+	"casso/pkg/resilience"
+	"casso/pkg/util/contextkey"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/logging"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	srcgrpc "google.golang.org/grpc"
+)
+
+// NewUserServiceClient user service rpc client
+func NewUserServiceClient(c *conf.Client, r registry.Discovery, h *health.Health, mp *metrics.Prometheus, logger log.Logger, tp *tracesdk.TracerProvider) (uv1.UserClient, func(), error) {
+	conn, err := dialService(c.GetUser(), c.GetBalancer(), "discovery:///casso.user.service.grpc", r, mp, logger, tp) // 三个`/`省略掉/default/
+	if err != nil {
+		return nil, nil, err
+	}
+	h.Register("user-service", health.Conn(conn))
+	return uv1.NewUserClient(conn), func() { _ = conn.Close() }, nil
+}
+
+// NewBoxServiceClient box service rpc client
+func NewBoxServiceClient(c *conf.Client, r registry.Discovery, h *health.Health, mp *metrics.Prometheus, logger log.Logger, tp *tracesdk.TracerProvider) (bv1.BoxClient, func(), error) {
+	conn, err := dialService(c.GetBox(), c.GetBalancer(), "discovery:///casso.box.service.grpc", r, mp, logger, tp)
+	if err != nil {
+		return nil, nil, err
+	}
+	h.Register("box-service", health.Conn(conn))
+	return bv1.NewBoxClient(conn), func() { _ = conn.Close() }, nil
+}
+
+func dialService(c *conf.Client_Service, balancerName, endpoint string, r registry.Discovery, mp *metrics.Prometheus, logger log.Logger, tp *tracesdk.TracerProvider) (*srcgrpc.ClientConn, error) {
+	if c.GetEndpoint() != "" {
+		endpoint = c.GetEndpoint()
+	}
+	// 负载均衡算法，未配置时使用 p2c
+	switch balancerName {
+	case "":
+		balancerName = balancer.P2C
+	case balancer.P2C, balancer.WRR:
+	default:
+		return nil, fmt.Errorf("client: unknown balancer %q", balancerName)
+	}
+	opts := []grpc.ClientOption{
+		grpc.WithEndpoint(endpoint),
+		grpc.WithDiscovery(r),
+		grpc.WithBalancerName(balancerName),
+		grpc.WithMiddleware(clientMiddleware(c, mp, logger, tp)...),
+		// 按灰度版本与可用区过滤实例
+		grpc.WithFilter(balancer.Filters(balancer.LocalZone())...),
+	}
+	// 整体超时包括重试，未配置时使用 kratos 默认的 2s
+	if c.GetTimeout() != nil {
+		opts = append(opts, grpc.WithTimeout(c.Timeout.AsDuration()))
+	}
+	return grpc.DialInsecure(context.Background(), opts...)
+}
+
+// clientMiddleware 由外到内：链路追踪、日志、监控、上下文传递、降级、重试、熔断、单次超时
+func clientMiddleware(c *conf.Client_Service, mp *metrics.Prometheus, logger log.Logger, tp *tracesdk.TracerProvider) []middleware.Middleware {
+	ms := []middleware.Middleware{
+		tracing.Client(tracing.WithTracerProvider(tp)),
+		logging.Client(logger),
+		mp.Client(),
+		// 将登录用户、语言、请求ID等传递给下游服务
+		contextkey.Client(),
+	}
+	if fb := c.GetFallback(); len(fb.GetMethods()) > 0 {
+		ms = append(ms, resilience.Fallback(resilience.NewStaleCache(fb.GetTtl().AsDuration(), int(fb.GetSize())), fb.Methods...))
+	}
+	if rt := c.GetRetry(); rt.GetAttempts() > 1 {
+		ms = append(ms, resilience.Retry(
+			resilience.WithAttempts(int(rt.Attempts)),
+			resilience.WithBackoff(rt.GetBackoff().AsDuration(), rt.GetMaxBackoff().AsDuration()),
+			resilience.WithMethods(rt.Methods...),
+		))
+	}
+	if b := c.GetBreaker(); !b.GetDisabled() {
+		ms = append(ms, resilience.Breaker(
+			resilience.WithSuccess(b.GetSuccess()),
+			resilience.WithRequest(b.GetRequest()),
+			resilience.WithWindow(b.GetWindow().AsDuration(), int(b.GetBucket())),
+		))
+	}
+	timeouts := make(map[string]time.Duration, len(c.GetMethodTimeouts()))
+	for m, d := range c.GetMethodTimeouts() {
+		timeouts[m] = d.AsDuration()
+	}
+	return append(ms, resilience.Timeout(c.GetMethodTimeout().AsDuration(), timeouts))
+}
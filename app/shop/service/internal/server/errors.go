@@ -0,0 +1,42 @@
+package server
+
+import (
+	bv1 "casso/api/box/service/v1"
+	v1 "casso/api/shop/service/v1"
+	uv1 "casso/api/user/service/v1"
+	"casso/pkg/errors"
+)
+
+const loginOperation = "/api.shop.service.v1.Shop/Login"
+
+// NewErrorMapper 对外错误映射表
+// 下游 USER_*、BOX_* 与 pkg/errors 中的内部错误在此转换为 SHOP_*，未声明的错误统一返回 SHOP_INTERNAL_ERROR；
+// 新增下游错误时需在此声明，否则客户端只能看到服务繁忙
+func NewErrorMapper() *errors.Mapper {
+	rules := []errors.Rule{
+		{Reason: uv1.UserServiceErrorReason_USER_INVALID_PARAMS.String(), To: v1.ErrorShopInvalidParams("invalid params")},
+		{Reason: uv1.UserServiceErrorReason_USER_CONTENT_MISSING.String(), To: v1.ErrorShopContentMissing("content missing")},
+		{Reason: uv1.UserServiceErrorReason_USER_RECORD_NOT_FOUND.String(), To: v1.ErrorShopRecordNotFound("record not found")},
+		{Reason: uv1.UserServiceErrorReason_USER_DUPLICATE_ENTRY.String(), To: v1.ErrorShopDuplicateEntry("duplicate entry"), Metadata: []string{"field"}},
+		{Reason: bv1.BoxServiceErrorReason_BOX_INVALID_PARAMS.String(), To: v1.ErrorShopInvalidParams("invalid params")},
+		{Reason: bv1.BoxServiceErrorReason_BOX_CAMPAIGN_NOT_FOUND.String(), To: v1.ErrorShopRecordNotFound("record not found")},
+		{Reason: bv1.BoxServiceErrorReason_BOX_CAMPAIGN_NOT_ACTIVE.String(), To: v1.ErrorShopBoxUnavailable("box unavailable")},
+		{Reason: bv1.BoxServiceErrorReason_BOX_SOLD_OUT.String(), To: v1.ErrorShopBoxSoldOut("box sold out")},
+		{Reason: bv1.BoxServiceErrorReason_BOX_TOO_MANY.String(), To: v1.ErrorShopBoxTooMany("too many boxes"), Metadata: []string{"max"}},
+		{Reason: errors.InvalidParams.Reason, To: v1.ErrorShopInvalidParams("invalid params")},
+		{Reason: errors.RecordNotFound.Reason, To: v1.ErrorShopRecordNotFound("record not found")},
+		{Reason: errors.UserNotExist.Reason, To: v1.ErrorShopRecordNotFound("record not found")},
+		{Reason: errors.ErrAuthFail.Reason, To: v1.ErrorShopInvalidToken("invalid token")},
+		// 登录时不区分手机号不存在与密码错误
+		{Operation: loginOperation, Reason: uv1.UserServiceErrorReason_USER_INVALID_PASS.String(), To: v1.ErrorShopLoginFailed("login failed")},
+		{Operation: loginOperation, Reason: errors.RecordNotFound.Reason, To: v1.ErrorShopLoginFailed("login failed")},
+		{Operation: loginOperation, Reason: uv1.UserServiceErrorReason_USER_RECORD_NOT_FOUND.String(), To: v1.ErrorShopLoginFailed("login failed")},
+		// kratos 中间件产生的错误
+		{Reason: "VALIDATOR", To: v1.ErrorShopInvalidParams("invalid params")},
+		{Reason: "RATELIMIT"},
+	}
+	return errors.NewMapper(rules,
+		errors.WithPublicDomains("shop", "idempotent"),
+		errors.WithFallback(v1.ErrorShopInternalError("service busy, please try again later")),
+	)
+}
@@ -10,36 +10,43 @@ package server
 
 import (
 	v1 "casso/api/shop/service/v1"
-	uv1 "casso/api/user/service/v1"
 	"casso/app/shop/service/internal/conf"
 	"casso/app/shop/service/internal/service"
-	"context"
-
-	nr "github.com/go-kratos/nacos/registry"
+	"casso/pkg/errors"
+	"casso/pkg/health"
+	"casso/pkg/i18n"
+	"casso/pkg/idempotent"
+	"casso/pkg/metrics"
+	pkgregistry "casso/pkg/registry"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/registry"
-	"github.com/nacos-group/nacos-sdk-go/clients"
-	"github.com/nacos-group/nacos-sdk-go/common/constant"
-	"github.com/nacos-group/nacos-sdk-go/vo"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 
 	"github.com/go-kratos/kratos/v2/middleware/logging"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, logger log.Logger, tp *tracesdk.TracerProvider, s *service.ShopService) *grpc.Server {
+func NewGRPCServer(c *conf.Server, logger log.Logger, tp *tracesdk.TracerProvider, s *service.ShopService, guard *idempotent.Guard, catalog *i18n.Catalog, mapper *errors.Mapper, h *health.Health, mp *metrics.Prometheus) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			i18n.Server(catalog),
+			errors.Server(mapper, logger),
 			tracing.Server(
 				tracing.WithTracerProvider(tp)),
 			logging.Server(logger),
-			logging.Client(logger),
+			mp.Server(),
+			selector.Server(
+				idempotent.Server(guard),
+			).Path("/api.shop.service.v1.Shop/Register").Build(),
 		),
+		// 依赖未就绪时健康检查返回 NOT_SERVING
+		grpc.UnaryInterceptor(h.UnaryInterceptor()),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -55,48 +62,7 @@ func NewGRPCServer(c *conf.Server, logger log.Logger, tp *tracesdk.TracerProvide
 	return srv
 }
 
-func NewDiscovery(conf *conf.Discovery) registry.Discovery {
-	sc := []constant.ServerConfig{
-		*constant.NewServerConfig(conf.Nacos.Address, uint64(conf.Nacos.Port)),
-	}
-
-	cc := &constant.ClientConfig{
-		NamespaceId:         "public", //namespace id
-		TimeoutMs:           5000,
-		NotLoadCacheAtStart: true,
-		LogDir:              "/tmp/nacos/log",
-		CacheDir:            "/tmp/nacos/cache",
-		RotateTime:          "1h",
-		MaxAge:              3,
-		LogLevel:            "debug",
-	}
-
-	// a more graceful way to create naming client
-	client, err := clients.NewNamingClient(
-		vo.NacosClientParam{
-			ClientConfig:  cc,
-			ServerConfigs: sc,
-		},
-	)
-
-	if err != nil {
-		panic("err")
-	}
-
-	r := nr.New(client)
-
-	return r
-}
-
-// NewUserServiceClient user service rpc client
-func NewUserServiceClient(r registry.Discovery) uv1.UserClient {
-	conn, err := grpc.DialInsecure(
-		context.Background(),
-		grpc.WithEndpoint("discovery:///casso.user.service.grpc"), // 三个`/`省略掉/default/
-		grpc.WithDiscovery(r),
-	)
-	if err != nil {
-		panic(err)
-	}
-	return uv1.NewUserClient(conn)
+// NewDiscovery 按 conf.Discovery 的 backend 创建服务发现，默认 nacos
+func NewDiscovery(c *conf.Discovery) (registry.Discovery, func(), error) {
+	return pkgregistry.NewDiscovery(c)
 }
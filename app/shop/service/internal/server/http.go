@@ -12,10 +12,15 @@ import (
 	v1 "casso/api/shop/service/v1"
 	"casso/app/shop/service/internal/conf"
 	"casso/app/shop/service/internal/service"
+	"casso/pkg/errors"
+	"casso/pkg/health"
+	"casso/pkg/i18n"
+	"casso/pkg/idempotent"
+	"casso/pkg/metrics"
 	"casso/pkg/util/contextkey"
 	"casso/pkg/util/resencoder"
 	"context"
-	"fmt"
+	"strconv"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware"
@@ -32,17 +37,35 @@ import (
 )
 
 // NewHTTPServer new a HTTP server.
-func NewHTTPServer(c *conf.Server, logger log.Logger, tp *tracesdk.TracerProvider, s *service.ShopService) *http.Server {
+func NewHTTPServer(c *conf.Server, logger log.Logger, tp *tracesdk.TracerProvider, s *service.ShopService, guard *idempotent.Guard, catalog *i18n.Catalog, mapper *errors.Mapper, h *health.Health, mp *metrics.Prometheus) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
+			// 所有接口都恢复 panic，并从 traceparent/baggage 继续上游的链路
+			recovery.Recovery(),
+			tracing.Server(tracing.WithTracerProvider(tp)),
+			// 解析请求ID、租户、灰度版本与客户端信息，经 contextkey.Client 中间件传递给下游服务
+			contextkey.Edge(),
+			logging.Server(logger), // 添加全局日志中间件
+			// 按接口与对外的错误码统计请求数与耗时
+			mp.Server(),
+			// 按 Accept-Language 翻译返回的错误提示
+			i18n.Server(catalog),
+			// 下游与内部错误转换为对外的 SHOP_* 错误
+			errors.Server(mapper, logger),
 			selector.Server(
-				recovery.Recovery(),
-				tracing.Server(tracing.WithTracerProvider(tp)),
-				logging.Server(logger),
 				AuthMiddleware,
-			).Path("/api.shop.service.v1.Shop/GetUser").Build(),
-			logging.Client(logger), // 添加全局日志中间件
-			ratelimit.Server(),     // 启用过载保护（默认一个时间窗口 100 pass）
+			).Path(
+				"/api.shop.service.v1.Shop/GetUser",
+				"/api.shop.service.v1.Shop/OpenBox",
+				"/api.shop.service.v1.Shop/ListBoxDraws",
+				"/api.shop.service.v1.Shop/GetBoxSeed",
+				"/api.shop.service.v1.Shop/RotateBoxSeed",
+			).Build(),
+			// 携带 Idempotency-Key 的重复请求直接返回首次结果
+			selector.Server(
+				idempotent.Server(guard),
+			).Path("/api.shop.service.v1.Shop/Register").Build(),
+			ratelimit.Server(), // 启用过载保护（默认一个时间窗口 100 pass）
 		),
 	}
 
@@ -61,33 +84,41 @@ func NewHTTPServer(c *conf.Server, logger log.Logger, tp *tracesdk.TracerProvide
 	opts = append(opts, http.Filter(handlers.CORS(
 		handlers.AllowedOrigins([]string{"*"}),
 		handlers.AllowedMethods([]string{"GET", "POST", "PUT", "DELETE"}),
-		handlers.AllowedHeaders([]string{"DNT", "X-Mx-ReqToken", "Keep-Alive", "User-Agent", "X-Requested-With", "If-Modified-Since", "Cache-Control", "Content-Type", "Authorization", "udid", "appkey", "version", "authenticated", "cookie", "token"}),
+		handlers.AllowedHeaders([]string{"DNT", "X-Mx-ReqToken", "Keep-Alive", "User-Agent", "X-Requested-With", "If-Modified-Since", "Cache-Control", "Content-Type", "Authorization", "udid", "appkey", "version", "authenticated", "cookie", "token", "x-canary"}),
 		handlers.ExposedHeaders([]string{"DNT", "X-Mx-ReqToken", "Keep-Alive", "User-Agent", "X-Requested-With", "If-Modified-Since", "Cache-Control", "Content-Type", "Authorization", "udid", "appkey", "version", "authenticated", "cookie", "token"}),
 		handlers.OptionStatusCode(204),
 	)))
 
-	// 指定为json编码格式
-	opts = append(opts, http.ResponseEncoder(resencoder.ResponeJsonDeco()))
+	// 按 Accept 协商编码格式，成功与失败统一返回 {code, reason, message, data, request_id}
+	enc := resencoder.New()
+	opts = append(opts, http.ResponseEncoder(enc.Response()), http.ErrorEncoder(enc.Error()))
+	// 按 Content-Type 中的 charset 将 GBK、BIG5 等请求体转换为 UTF-8
+	opts = append(opts, http.RequestDecoder(RequestDecoder))
 	srv := http.NewServer(opts...)
 	v1.RegisterShopHTTPServer(srv, s)
+	srv.Handle("/metrics", mp.Handler())
+	// 存活与就绪探针
+	srv.HandleFunc("/healthz", h.Liveness)
+	srv.HandleFunc("/readyz", h.Readiness)
 
 	return srv
 }
 
-// AuthMiddleware 网关服务会将userid添加到查询参数打到本服务。次中间件将userid 添加到上下文中
+// AuthMiddleware 网关服务会将userid添加到查询参数打到本服务。此中间件将userid 添加到上下文中，缺少或不合法时拒绝请求
 func AuthMiddleware(handler middleware.Handler) middleware.Handler {
-	return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
-		reply, err = handler(ctx, req)
-		if tr, ok := transport.FromServerContext(ctx); ok {
-			ht, _ := tr.(*http.Transport)
-			if len(ht.Request().URL.Query()[contextkey.UserID]) > 0 {
-				k := contextkey.NewKey
-				v := ht.Request().URL.Query()[contextkey.UserID][0]
-				fmt.Printf("登陆uid:%s", v)
-				etxs := context.WithValue(ctx, k, v)
-				reply, err = handler(etxs, req)
-			}
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		tr, ok := transport.FromServerContext(ctx)
+		if !ok {
+			return nil, errors.ErrAuthFail
+		}
+		ht, ok := tr.(*http.Transport)
+		if !ok {
+			return nil, errors.ErrAuthFail
+		}
+		uid, err := strconv.ParseInt(ht.Request().URL.Query().Get("userid"), 10, 64)
+		if err != nil || uid <= 0 {
+			return nil, errors.ErrAuthFail
 		}
-		return
+		return handler(contextkey.UserID.With(ctx, uid), req)
 	}
 }
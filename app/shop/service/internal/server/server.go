@@ -9,8 +9,10 @@
 package server
 
 import (
+	"casso/pkg/i18n"
+
 	"github.com/google/wire"
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewUserServiceClient, NewDiscovery)
+var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewUserServiceClient, NewBoxServiceClient, NewDiscovery, i18n.Default, NewErrorMapper)
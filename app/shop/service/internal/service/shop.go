@@ -12,14 +12,12 @@ import (
 	pb "casso/api/shop/service/v1"
 	"casso/app/shop/service/internal/biz"
 	"casso/pkg/errors"
+	"casso/pkg/util/contextkey"
 	"context"
-	"strconv"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
 
-var MockUID int64 = 2333
-
 type ShopService struct {
 	pb.UnimplementedShopServer
 
@@ -33,17 +31,9 @@ func NewShopService(sc *biz.ShopUseCase, logger log.Logger) *ShopService {
 		log: log.NewHelper(log.With(logger, "module", "service/shop"))}
 }
 
-// GetUserID 从上下文中获取userid；取出的为interface，需要断言
+// GetUserID 从上下文中获取 AuthMiddleware 写入的userid
 func (s *ShopService) GetUserID(ctx context.Context) int64 {
-	inter_id := ctx.Value("userid")
-	if inter_id == nil {
-		return MockUID
-	}
-	id, err := strconv.Atoi(inter_id.(string))
-	if err != nil {
-		return MockUID
-	}
-	return int64(id)
+	return contextkey.UserID.Value(ctx)
 }
 
 func (s *ShopService) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterReply, error) {
@@ -68,3 +58,57 @@ func (s *ShopService) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.
 	// 调用业务用例
 	return s.sc.GetUser(ctx, s.GetUserID(ctx))
 }
+
+func (s *ShopService) ListBoxCampaigns(ctx context.Context, req *pb.ListBoxCampaignsRequest) (*pb.ListBoxCampaignsReply, error) {
+	// 数据校验
+	if req.Page <= 0 || req.Limit <= 0 || req.Limit > 100 {
+		return &pb.ListBoxCampaignsReply{}, errors.InvalidParams
+	}
+	// 调用业务用例
+	return s.sc.ListBoxCampaigns(ctx, req)
+}
+
+func (s *ShopService) GetBoxCampaign(ctx context.Context, req *pb.GetBoxCampaignRequest) (*pb.GetBoxCampaignReply, error) {
+	// 数据校验
+	if req.Id <= 0 {
+		return &pb.GetBoxCampaignReply{}, errors.InvalidParams
+	}
+	// 调用业务用例
+	return s.sc.GetBoxCampaign(ctx, req.Id)
+}
+
+func (s *ShopService) OpenBox(ctx context.Context, req *pb.OpenBoxRequest) (*pb.OpenBoxReply, error) {
+	// 数据校验
+	if req.CampaignId <= 0 || req.Count <= 0 || req.RequestId == "" || len(req.RequestId) > 64 {
+		return &pb.OpenBoxReply{}, errors.InvalidParams
+	}
+	// 调用业务用例
+	return s.sc.OpenBox(ctx, s.GetUserID(ctx), req)
+}
+
+func (s *ShopService) ListBoxDraws(ctx context.Context, req *pb.ListBoxDrawsRequest) (*pb.ListBoxDrawsReply, error) {
+	// 数据校验
+	if req.CampaignId < 0 || req.Page <= 0 || req.Limit <= 0 || req.Limit > 100 {
+		return &pb.ListBoxDrawsReply{}, errors.InvalidParams
+	}
+	// 调用业务用例
+	return s.sc.ListBoxDraws(ctx, s.GetUserID(ctx), req)
+}
+
+func (s *ShopService) GetBoxSeed(ctx context.Context, req *pb.GetBoxSeedRequest) (*pb.GetBoxSeedReply, error) {
+	// 数据校验
+	if req.CampaignId <= 0 {
+		return &pb.GetBoxSeedReply{}, errors.InvalidParams
+	}
+	// 调用业务用例
+	return s.sc.GetBoxSeed(ctx, s.GetUserID(ctx), req.CampaignId)
+}
+
+func (s *ShopService) RotateBoxSeed(ctx context.Context, req *pb.RotateBoxSeedRequest) (*pb.RotateBoxSeedReply, error) {
+	// 数据校验
+	if req.CampaignId <= 0 || len(req.ClientSeed) > 64 {
+		return &pb.RotateBoxSeedReply{}, errors.InvalidParams
+	}
+	// 调用业务用例
+	return s.sc.RotateBoxSeed(ctx, s.GetUserID(ctx), req)
+}
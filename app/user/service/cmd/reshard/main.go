@@ -0,0 +1,83 @@
+package main
+
+import (
+	"casso/app/user/service/internal/conf"
+	"casso/app/user/service/internal/data"
+	"casso/app/user/service/internal/model"
+	"casso/pkg/config"
+	"casso/pkg/util/shard"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// 用户分表数据迁移:
+//
+//	go run ./cmd/reshard -conf ../../configs backfill             旧 user 表数据复制到分表
+//	go run ./cmd/reshard -conf ../../configs -from 16 -to 32 move  分表扩容，-to 默认取配置 shards
+//
+// 扩容步骤：迁移创建新分表 -> 停止写入 -> move -> 修改配置 shards 并重启服务
+var (
+	flagconf string
+	from     int
+	to       int
+	batch    int
+	dryRun   bool
+)
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.IntVar(&from, "from", 0, "current shard count (move only)")
+	flag.IntVar(&to, "to", 0, "target shard count, defaults to data.database.shards")
+	flag.IntVar(&batch, "batch", 500, "rows per batch")
+	flag.BoolVar(&dryRun, "dry-run", false, "scan and report without writing")
+}
+
+func main() {
+	flag.Parse()
+
+	c, err := config.Open(flagconf)
+	if err != nil {
+		panic(err)
+	}
+	defer c.Close()
+
+	bc, err := config.Load[conf.Bootstrap](c, "")
+	if err != nil {
+		panic(err)
+	}
+
+	target := data.NewShardRouter(bc.Data)
+	if to > 0 {
+		target = shard.NewRouter(model.UserTableName, to)
+	}
+	r := &data.Resharder{
+		DB:     data.NewDB(bc.Data, nil, tracesdk.NewTracerProvider(), log.DefaultLogger), // 命令行工具不统计指标；TracerProvider 没有 exporter，不上报链路
+		Batch:  batch,
+		DryRun: dryRun,
+		Out:    os.Stdout,
+	}
+
+	var total int64
+	switch flag.Arg(0) {
+	case "backfill":
+		total, err = r.Backfill(context.Background(), target)
+	case "move":
+		if from <= 0 {
+			err = fmt.Errorf("reshard: -from is required")
+			break
+		}
+		total, err = r.Reshard(context.Background(), shard.NewRouter(model.UserTableName, from), target)
+	default:
+		err = fmt.Errorf("reshard: unknown command %q, want backfill|move", flag.Arg(0))
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Printf("done, %d rows\n", total)
+}
@@ -2,20 +2,20 @@ package main
 
 import (
 	"casso/app/user/service/internal/conf"
+	"casso/pkg/balancer"
+	"casso/pkg/config"
+	"casso/pkg/health"
+	"casso/pkg/logging"
+	"casso/pkg/metrics"
+	"casso/pkg/tracing"
+	"casso/pkg/util/token"
 	"flag"
-	"os"
 
 	"github.com/go-kratos/kratos/v2"
-	"github.com/go-kratos/kratos/v2/config"
-	"github.com/go-kratos/kratos/v2/config/file"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/registry"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
-	"go.opentelemetry.io/otel/exporters/jaeger"
-	"go.opentelemetry.io/otel/sdk/resource"
-	tracesdk "go.opentelemetry.io/otel/sdk/trace"
-	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
-	"gopkg.in/yaml.v2"
+	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
 // go build -ldflags "-X main.Version=x.y.z"
@@ -32,14 +32,16 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, rr registry.Registrar) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, rr registry.Registrar, h *health.Health) *kratos.App {
 	return kratos.New(
 		kratos.Name(Name),
 		kratos.Version(Version),
-		kratos.Metadata(map[string]string{}),
+		kratos.Metadata(balancer.Metadata(Version)), // 版本、可用区、权重，用于负载均衡与灰度
 		kratos.Logger(logger),
 		kratos.Server(
 			gs,
+			hs,
+			h.Server(rr), // 定时检查依赖，未就绪时从注册中心注销
 		),
 		kratos.Registrar(rr),
 	)
@@ -47,53 +49,59 @@ func newApp(logger log.Logger, gs *grpc.Server, rr registry.Registrar) *kratos.A
 
 func main() {
 	flag.Parse() // 获取终端命令
-	logger := log.With(log.NewStdLogger(os.Stdout),
-		"service.name", Name,
-		"service.version", Version,
-		"ts", log.DefaultTimestamp,
-		"caller", log.DefaultCaller,
-	)
+	// 环境变量 CASSO_ 覆盖配置文件，配置加载时校验，非法的修改不生效
+	c, err := config.Open(flagconf)
+	if err != nil {
+		panic(err)
+	}
+	defer c.Close()
 
-	c := config.New(
-		config.WithSource(
-			file.NewSource(flagconf),
-		),
-		config.WithDecoder(func(kv *config.KeyValue, v map[string]interface{}) error {
-			return yaml.Unmarshal(kv.Value, v)
-		}),
-	)
-	if err := c.Load(); err != nil {
+	bc, err := config.Load[conf.Bootstrap](c, "") // config.yaml
+	if err != nil {
 		panic(err)
 	}
 
-	var bc conf.Bootstrap // config.yaml
-	if err := c.Scan(&bc); err != nil {
+	// JSON 日志，级别随配置 log.level 热更新
+	zl, err := logging.New(bc.Log)
+	if err != nil {
+		panic(err)
+	}
+	defer zl.Close()
+	logger := logging.With(zl, Name, Version)
+	c.SetLogger(logger)
+	if _, err := config.Watch(c, "log", func(_, new *logging.Config) {
+		_ = zl.SetLevel(new.GetLevel())
+	}); err != nil {
 		panic(err)
 	}
 
-	// 监听配置文件
-	if err := conf.LoadConf(c); err != nil {
+	// casso.yaml 自定义配置，修改后热更新
+	if _, err := config.Watch(c, "casso_conf", func(_, new *conf.CassoConf) {
+		log.NewHelper(logger).Infow("msg", "casso_conf reloaded", "items", len(*new))
+	}); err != nil {
 		panic(err)
 	}
 
-	var rc conf.Registry // registry.yaml
-	if err := c.Scan(&rc); err != nil {
+	// 签名密钥来自 secret，不在配置文件与代码中保存明文
+	token.SetSignKey(bc.Auth.JwtKey)
+
+	rc, err := config.Load[conf.Registry](c, "") // registry.yaml
+	if err != nil {
 		panic(err)
 	}
 
-	exp, err := jaeger.New(jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(bc.Trace.Endpoint)))
+	// 按配置上报到 OTLP collector，跨服务通过 W3C traceparent 传递
+	tp, cleanupTrace, err := tracing.NewTracerProvider(bc.Trace, Name, Version)
 	if err != nil {
 		panic(err)
 	}
-	tp := tracesdk.NewTracerProvider(
-		tracesdk.WithBatcher(exp),
-		tracesdk.WithResource(resource.NewSchemaless(
-			semconv.ServiceNameKey.String(Name),
-		)),
-	)
+	defer cleanupTrace()
+
+	// 全部指标带有服务名与版本标签，由管理端口的 /metrics 暴露
+	mp := metrics.NewPrometheus(Name, Version)
 
-	app, cleanup, err := initApp(bc.Server, &rc, bc.Data, logger, tp)
-	// app, cleanup, err := initApp(bc.Server, &rc, bc.Data, logger)
+	app, cleanup, err := initApp(bc.Server, rc, bc.Data, logger, tp, mp)
+	// app, cleanup, err := initApp(bc.Server, rc, bc.Data, logger)
 	if err != nil {
 		panic(err)
 	}
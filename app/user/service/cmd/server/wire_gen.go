@@ -12,6 +12,7 @@ import (
 	"casso/app/user/service/internal/data"
 	"casso/app/user/service/internal/server"
 	"casso/app/user/service/internal/service"
+	"casso/pkg/metrics"
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
 	"go.opentelemetry.io/otel/sdk/trace"
@@ -20,20 +21,44 @@ import (
 // Injectors from wire.go:
 
 // initApp init kratos application.
-func initApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Data, logger log.Logger, tracerProvider *trace.TracerProvider) (*kratos.App, func(), error) {
-	db := data.NewDB(confData, logger)
-	client := data.NewRd(confData, logger)
-	dataData, cleanup, err := data.NewData(db, client, logger)
+func initApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Data, logger log.Logger, tracerProvider *trace.TracerProvider, prometheus *metrics.Prometheus) (*kratos.App, func(), error) {
+	db := data.NewDB(confData, prometheus, tracerProvider, logger)
+	router, cleanup, err := data.NewRouter(confData, db, prometheus, tracerProvider, logger)
 	if err != nil {
 		return nil, nil, err
 	}
-	userRepo := data.NewUserRepo(dataData, logger)
-	userUseCase := biz.NewUserUseCase(userRepo, logger)
+	shardRouter := data.NewShardRouter(confData)
+	client := data.NewRd(confData, prometheus, tracerProvider, logger)
+	dataData, cleanup2, err := data.NewData(confData, db, router, shardRouter, client, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	idGenerator, cleanup3, err := data.NewIDGenerator(confData, client, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	userRepo := data.NewUserRepo(dataData, idGenerator, logger)
+	transaction := data.NewTransaction(dataData)
+	userUseCase := biz.NewUserUseCase(userRepo, transaction, logger)
 	userService := service.NewUserService(userUseCase, logger)
-	grpcServer := server.NewGRPCServer(confServer, logger, tracerProvider, userService)
-	registrar := server.NewRegistrar(registry)
-	app := newApp(logger, grpcServer, registrar)
+	health := data.NewHealth(confData, dataData, logger)
+	grpcServer := server.NewGRPCServer(confServer, logger, tracerProvider, userService, health, prometheus)
+	httpServer := server.NewHTTPServer(confServer, health, prometheus)
+	registrar, cleanup4, err := server.NewRegistrar(registry)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	app := newApp(logger, grpcServer, httpServer, registrar, health)
 	return app, func() {
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -10,9 +10,10 @@ var ProviderSet = wire.NewSet(NewUserUseCase)
 
 type UserUseCase struct {
 	repo UserRepo
+	tx   Transaction
 	log  *log.Helper
 }
 
-func NewUserUseCase(repo UserRepo, logger log.Logger) *UserUseCase {
-	return &UserUseCase{repo: repo, log: log.NewHelper(log.With(logger, "module", "usecase/user"))}
+func NewUserUseCase(repo UserRepo, tx Transaction, logger log.Logger) *UserUseCase {
+	return &UserUseCase{repo: repo, tx: tx, log: log.NewHelper(log.With(logger, "module", "usecase/user"))}
 }
@@ -5,6 +5,13 @@ import (
 	"context"
 )
 
+// Transaction 事务，由data层实现；在 fn 内通过 ctx 调用的 repo 方法会加入同一个事务
+type Transaction interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+	// AfterCommit 事务提交后执行（如发布事件），回滚时不执行
+	AfterCommit(ctx context.Context, fn func(ctx context.Context))
+}
+
 // 在此实现对data层的数据操作
 type UserRepo interface {
 	// 新建用户
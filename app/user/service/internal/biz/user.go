@@ -5,22 +5,25 @@ import (
 	"casso/app/user/service/internal/model"
 	"casso/app/user/service/internal/pkg/utill/passmd5"
 	"casso/pkg/errors"
+	"casso/pkg/util/mapper"
 	"casso/pkg/util/token"
 	"context"
 )
 
+// replyMapper model 到 api 回复的映射，回复中新增的字段在 model 中没有对应时直接报错
+var replyMapper = mapper.New(mapper.Strict())
+
 // ********* 以下实现业务组装，实现service需求 ***********
 func (uc *UserUseCase) CreateUser(ctx context.Context, u *model.User) (*user_proto.CreateUserReply, error) {
 	res, err := uc.repo.Create(ctx, u)
 	if err != nil {
 		return &user_proto.CreateUserReply{}, err
 	}
-	return &user_proto.CreateUserReply{
-		Id:       int64(res.ID),
-		Mobile:   res.Mobile,
-		NickName: res.Name,
-		Age:      res.Age,
-	}, nil
+	reply := &user_proto.CreateUserReply{}
+	if err := replyMapper.Map(reply, res); err != nil {
+		return reply, err
+	}
+	return reply, nil
 }
 
 func (uc *UserUseCase) GetUser(ctx context.Context, id int64) (*user_proto.GetUserReply, error) {
@@ -28,12 +31,11 @@ func (uc *UserUseCase) GetUser(ctx context.Context, id int64) (*user_proto.GetUs
 	if err != nil {
 		return &user_proto.GetUserReply{}, err
 	}
-	return &user_proto.GetUserReply{
-		Id:       int64(res.ID),
-		Mobile:   res.Mobile,
-		NickName: res.Mobile,
-		Age:      res.Age,
-	}, nil
+	reply := &user_proto.GetUserReply{}
+	if err := replyMapper.Map(reply, res); err != nil {
+		return reply, err
+	}
+	return reply, nil
 }
 
 func (uc *UserUseCase) DeleteUser(ctx context.Context, id int64) (*user_proto.DeleteUserReply, error) {
@@ -50,36 +52,40 @@ func (uc *UserUseCase) DeleteUser(ctx context.Context, id int64) (*user_proto.De
 
 func (uc *UserUseCase) UpdateUser(ctx context.Context, req *user_proto.UpdateUserRequest) (*user_proto.UpdateUserReply, error) {
 	var user model.User
-	user.ID = uint(req.Id)
+	user.ID = req.Id
 	user.Age = req.Age
 	user.Name = req.NickName
-	res, err := uc.repo.Update(ctx, &user)
+
+	// 查询与更新在同一个事务中完成，避免更新不存在的用户
+	var res *model.User
+	err := uc.tx.InTx(ctx, func(ctx context.Context) (err error) {
+		if _, err = uc.repo.Get(ctx, req.Id); err != nil {
+			return err
+		}
+		res, err = uc.repo.Update(ctx, &user)
+		return err
+	})
 	if err != nil {
 		return &user_proto.UpdateUserReply{}, err
 	}
-	return &user_proto.UpdateUserReply{
-		Mobile:   res.Mobile,
-		NickName: res.Name,
-		Age:      res.Age,
-	}, nil
+	reply := &user_proto.UpdateUserReply{}
+	if err := replyMapper.Map(reply, res); err != nil {
+		return reply, err
+	}
+	return reply, nil
 }
 
 func (uc *UserUseCase) UserList(ctx context.Context, pageNum, pageSize int64) (*user_proto.ListUserReply, error) {
-	var res []*user_proto.ListUserReply_User
 	list, err := uc.repo.List(ctx, pageNum, pageSize)
 	if err != nil {
 		return &user_proto.ListUserReply{}, err
 	}
 
-	// 拼接数据
-	for _, v := range list {
-		res = append(res, &user_proto.ListUserReply_User{
-			Id:       int64(v.ID),
-			Mobile:   v.Mobile,
-			NickName: v.Name,
-		})
+	reply := &user_proto.ListUserReply{}
+	if err := replyMapper.Map(&reply.Users, list); err != nil {
+		return reply, err
 	}
-	return &user_proto.ListUserReply{Users: res}, nil
+	return reply, nil
 }
 
 func (uc *UserUseCase) Login(ctx context.Context, u *user_proto.GetTokenRequest) (res *user_proto.GetTokenReply, err error) {
@@ -89,7 +95,7 @@ func (uc *UserUseCase) Login(ctx context.Context, u *user_proto.GetTokenRequest)
 	}
 
 	if user.Pass != passmd5.Base64Md5(u.Pass) {
-		return res, errors.InvalidParams
+		return res, user_proto.ErrorUserInvalidPass("invalid password")
 	}
 
 	t, err := token.NewJWT().CreateToken(token.CustomClaims{
@@ -97,7 +103,7 @@ func (uc *UserUseCase) Login(ctx context.Context, u *user_proto.GetTokenRequest)
 	})
 
 	if err != nil {
-		return res, errors.MakeTokenFaild
+		return res, errors.MakeTokenFailed
 	}
 	return &user_proto.GetTokenReply{Token: t}, nil
 }
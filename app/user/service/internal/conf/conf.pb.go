@@ -7,6 +7,10 @@
 package conf
 
 import (
+	config "casso/pkg/config"
+	logging "casso/pkg/logging"
+	registry "casso/pkg/registry"
+	tracing "casso/pkg/tracing"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
@@ -26,9 +30,13 @@ type Bootstrap struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Trace  *Trace  `protobuf:"bytes,1,opt,name=trace,proto3" json:"trace,omitempty"`
-	Server *Server `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
-	Data   *Data   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Trace  *tracing.Config `protobuf:"bytes,1,opt,name=trace,proto3" json:"trace,omitempty"`
+	Server *Server         `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	Data   *Data           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Log    *logging.Config `protobuf:"bytes,4,opt,name=log,proto3" json:"log,omitempty"`
+	Config *config.Remote  `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"` // 远程配置源，只在启动时读取
+	Secret *config.Secret  `protobuf:"bytes,6,opt,name=secret,proto3" json:"secret,omitempty"` // 配置中 ${secret:name} 的来源，只在启动时读取
+	Auth   *Auth           `protobuf:"bytes,7,opt,name=auth,proto3" json:"auth,omitempty"`
 }
 
 func (x *Bootstrap) Reset() {
@@ -63,7 +71,7 @@ func (*Bootstrap) Descriptor() ([]byte, []int) {
 	return file_app_user_service_internal_conf_conf_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Bootstrap) GetTrace() *Trace {
+func (x *Bootstrap) GetTrace() *tracing.Config {
 	if x != nil {
 		return x.Trace
 	}
@@ -84,16 +92,45 @@ func (x *Bootstrap) GetData() *Data {
 	return nil
 }
 
-type Trace struct {
+func (x *Bootstrap) GetLog() *logging.Config {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetConfig() *config.Remote {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetSecret() *config.Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetAuth() *Auth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+// Auth 登录令牌
+type Auth struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	JwtKey string `protobuf:"bytes,1,opt,name=jwt_key,json=jwtKey,proto3" json:"jwt_key,omitempty"` // HS256 签名密钥，通过 ${secret:name} 注入
 }
 
-func (x *Trace) Reset() {
-	*x = Trace{}
+func (x *Auth) Reset() {
+	*x = Auth{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_user_service_internal_conf_conf_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -101,13 +138,13 @@ func (x *Trace) Reset() {
 	}
 }
 
-func (x *Trace) String() string {
+func (x *Auth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Trace) ProtoMessage() {}
+func (*Auth) ProtoMessage() {}
 
-func (x *Trace) ProtoReflect() protoreflect.Message {
+func (x *Auth) ProtoReflect() protoreflect.Message {
 	mi := &file_app_user_service_internal_conf_conf_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -119,14 +156,14 @@ func (x *Trace) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Trace.ProtoReflect.Descriptor instead.
-func (*Trace) Descriptor() ([]byte, []int) {
+// Deprecated: Use Auth.ProtoReflect.Descriptor instead.
+func (*Auth) Descriptor() ([]byte, []int) {
 	return file_app_user_service_internal_conf_conf_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Trace) GetEndpoint() string {
+func (x *Auth) GetJwtKey() string {
 	if x != nil {
-		return x.Endpoint
+		return x.JwtKey
 	}
 	return ""
 }
@@ -191,10 +228,10 @@ type Data struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Database *Data_Database `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis    *Data_Redis    `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
-	Apollo   *Data_Apollo   `protobuf:"bytes,3,opt,name=apollo,proto3" json:"apollo,omitempty"`
-	Kafka    *Data_Kafka    `protobuf:"bytes,4,opt,name=kafka,proto3" json:"kafka,omitempty"`
+	Database  *Data_Database  `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis     *Data_Redis     `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Kafka     *Data_Kafka     `protobuf:"bytes,4,opt,name=kafka,proto3" json:"kafka,omitempty"`
+	Snowflake *Data_Snowflake `protobuf:"bytes,5,opt,name=snowflake,proto3" json:"snowflake,omitempty"`
 }
 
 func (x *Data) Reset() {
@@ -243,16 +280,16 @@ func (x *Data) GetRedis() *Data_Redis {
 	return nil
 }
 
-func (x *Data) GetApollo() *Data_Apollo {
+func (x *Data) GetKafka() *Data_Kafka {
 	if x != nil {
-		return x.Apollo
+		return x.Kafka
 	}
 	return nil
 }
 
-func (x *Data) GetKafka() *Data_Kafka {
+func (x *Data) GetSnowflake() *Data_Snowflake {
 	if x != nil {
-		return x.Kafka
+		return x.Snowflake
 	}
 	return nil
 }
@@ -262,7 +299,11 @@ type Registry struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nacos *Registry_Nacos `protobuf:"bytes,1,opt,name=nacos,proto3" json:"nacos,omitempty"`
+	Backend string           `protobuf:"bytes,2,opt,name=backend,proto3" json:"backend,omitempty"` // nacos | etcd | consul | static | memory，默认 nacos
+	Nacos   *registry.Nacos  `protobuf:"bytes,1,opt,name=nacos,proto3" json:"nacos,omitempty"`
+	Etcd    *registry.Etcd   `protobuf:"bytes,3,opt,name=etcd,proto3" json:"etcd,omitempty"`
+	Consul  *registry.Consul `protobuf:"bytes,4,opt,name=consul,proto3" json:"consul,omitempty"`
+	Static  *registry.Static `protobuf:"bytes,5,opt,name=static,proto3" json:"static,omitempty"`
 }
 
 func (x *Registry) Reset() {
@@ -297,13 +338,41 @@ func (*Registry) Descriptor() ([]byte, []int) {
 	return file_app_user_service_internal_conf_conf_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Registry) GetNacos() *Registry_Nacos {
+func (x *Registry) GetBackend() string {
+	if x != nil {
+		return x.Backend
+	}
+	return ""
+}
+
+func (x *Registry) GetNacos() *registry.Nacos {
 	if x != nil {
 		return x.Nacos
 	}
 	return nil
 }
 
+func (x *Registry) GetEtcd() *registry.Etcd {
+	if x != nil {
+		return x.Etcd
+	}
+	return nil
+}
+
+func (x *Registry) GetConsul() *registry.Consul {
+	if x != nil {
+		return x.Consul
+	}
+	return nil
+}
+
+func (x *Registry) GetStatic() *registry.Static {
+	if x != nil {
+		return x.Static
+	}
+	return nil
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -435,8 +504,15 @@ type Data_Database struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Driver string `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
-	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Driver         string                   `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	Source         string                   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`                                         // 主库
+	Isolation      string                   `protobuf:"bytes,3,opt,name=isolation,proto3" json:"isolation,omitempty"`                                   // 默认事务隔离级别，如 READ COMMITTED
+	Pool           *Data_Database_Pool      `protobuf:"bytes,4,opt,name=pool,proto3" json:"pool,omitempty"`                                             // 主库连接池
+	Replicas       []*Data_Database_Replica `protobuf:"bytes,5,rep,name=replicas,proto3" json:"replicas,omitempty"`                                     // 从库，为空时读写都走主库
+	ReadYourWrites *durationpb.Duration     `protobuf:"bytes,6,opt,name=read_your_writes,json=readYourWrites,proto3" json:"read_your_writes,omitempty"` // 写入后该用户的读操作走主库的时间窗口，记录在进程内存中，只对同一实例生效
+	HealthCheck    *durationpb.Duration     `protobuf:"bytes,7,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`            // 从库健康检查间隔，不健康的从库会被剔除
+	Shards         int32                    `protobuf:"varint,8,opt,name=shards,proto3" json:"shards,omitempty"`                                        // 用户分表数量，需与迁移创建的分表一致，扩容使用 cmd/reshard
+	SlowThreshold  *durationpb.Duration     `protobuf:"bytes,9,opt,name=slow_threshold,json=slowThreshold,proto3" json:"slow_threshold,omitempty"`      // 超过该耗时的 SQL 以 warn 级别输出 (200ms)
 }
 
 func (x *Data_Database) Reset() {
@@ -485,6 +561,55 @@ func (x *Data_Database) GetSource() string {
 	return ""
 }
 
+func (x *Data_Database) GetIsolation() string {
+	if x != nil {
+		return x.Isolation
+	}
+	return ""
+}
+
+func (x *Data_Database) GetPool() *Data_Database_Pool {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+func (x *Data_Database) GetReplicas() []*Data_Database_Replica {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+func (x *Data_Database) GetReadYourWrites() *durationpb.Duration {
+	if x != nil {
+		return x.ReadYourWrites
+	}
+	return nil
+}
+
+func (x *Data_Database) GetHealthCheck() *durationpb.Duration {
+	if x != nil {
+		return x.HealthCheck
+	}
+	return nil
+}
+
+func (x *Data_Database) GetShards() int32 {
+	if x != nil {
+		return x.Shards
+	}
+	return 0
+}
+
+func (x *Data_Database) GetSlowThreshold() *durationpb.Duration {
+	if x != nil {
+		return x.SlowThreshold
+	}
+	return nil
+}
+
 type Data_Redis struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -580,20 +705,19 @@ func (x *Data_Redis) GetPassword() string {
 	return ""
 }
 
-type Data_Apollo struct {
+type Data_Kafka struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addr      string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	AppId     string `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	Cluster   string `protobuf:"bytes,3,opt,name=cluster,proto3" json:"cluster,omitempty"`
-	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Screct    string `protobuf:"bytes,5,opt,name=screct,proto3" json:"screct,omitempty"`
+	Addr        []string `protobuf:"bytes,1,rep,name=addr,proto3" json:"addr,omitempty"`
+	SendTopic   []string `protobuf:"bytes,2,rep,name=send_topic,json=sendTopic,proto3" json:"send_topic,omitempty"`
+	ReciveTopic []string `protobuf:"bytes,3,rep,name=recive_topic,json=reciveTopic,proto3" json:"recive_topic,omitempty"`
+	Group       []string `protobuf:"bytes,4,rep,name=group,proto3" json:"group,omitempty"`
 }
 
-func (x *Data_Apollo) Reset() {
-	*x = Data_Apollo{}
+func (x *Data_Kafka) Reset() {
+	*x = Data_Kafka{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_user_service_internal_conf_conf_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -601,13 +725,13 @@ func (x *Data_Apollo) Reset() {
 	}
 }
 
-func (x *Data_Apollo) String() string {
+func (x *Data_Kafka) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data_Apollo) ProtoMessage() {}
+func (*Data_Kafka) ProtoMessage() {}
 
-func (x *Data_Apollo) ProtoReflect() protoreflect.Message {
+func (x *Data_Kafka) ProtoReflect() protoreflect.Message {
 	mi := &file_app_user_service_internal_conf_conf_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -619,74 +743,140 @@ func (x *Data_Apollo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data_Apollo.ProtoReflect.Descriptor instead.
-func (*Data_Apollo) Descriptor() ([]byte, []int) {
+// Deprecated: Use Data_Kafka.ProtoReflect.Descriptor instead.
+func (*Data_Kafka) Descriptor() ([]byte, []int) {
 	return file_app_user_service_internal_conf_conf_proto_rawDescGZIP(), []int{3, 2}
 }
 
-func (x *Data_Apollo) GetAddr() string {
+func (x *Data_Kafka) GetAddr() []string {
 	if x != nil {
 		return x.Addr
 	}
-	return ""
+	return nil
 }
 
-func (x *Data_Apollo) GetAppId() string {
+func (x *Data_Kafka) GetSendTopic() []string {
 	if x != nil {
-		return x.AppId
+		return x.SendTopic
 	}
-	return ""
+	return nil
 }
 
-func (x *Data_Apollo) GetCluster() string {
+func (x *Data_Kafka) GetReciveTopic() []string {
 	if x != nil {
-		return x.Cluster
+		return x.ReciveTopic
 	}
-	return ""
+	return nil
+}
+
+func (x *Data_Kafka) GetGroup() []string {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+// 雪花发号器节点ID，多副本部署时从 redis 租用，保证各副本节点ID不重复
+type Data_Snowflake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode     string               `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`                         // lease（默认，通过 redis 租用）| static（使用 node_id）
+	NodeId   int64                `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`      // 静态节点ID（0~1023），mode 为 static 时使用
+	LeaseTtl *durationpb.Duration `protobuf:"bytes,2,opt,name=lease_ttl,json=leaseTtl,proto3" json:"lease_ttl,omitempty"` // 租约有效期，默认 30s
+	Prefix   string               `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`                     // 租约 key 前缀
+}
+
+func (x *Data_Snowflake) Reset() {
+	*x = Data_Snowflake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_user_service_internal_conf_conf_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Data_Snowflake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Snowflake) ProtoMessage() {}
+
+func (x *Data_Snowflake) ProtoReflect() protoreflect.Message {
+	mi := &file_app_user_service_internal_conf_conf_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *Data_Apollo) GetNamespace() string {
+// Deprecated: Use Data_Snowflake.ProtoReflect.Descriptor instead.
+func (*Data_Snowflake) Descriptor() ([]byte, []int) {
+	return file_app_user_service_internal_conf_conf_proto_rawDescGZIP(), []int{3, 3}
+}
+
+func (x *Data_Snowflake) GetMode() string {
 	if x != nil {
-		return x.Namespace
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *Data_Apollo) GetScrect() string {
+func (x *Data_Snowflake) GetNodeId() int64 {
+	if x != nil {
+		return x.NodeId
+	}
+	return 0
+}
+
+func (x *Data_Snowflake) GetLeaseTtl() *durationpb.Duration {
+	if x != nil {
+		return x.LeaseTtl
+	}
+	return nil
+}
+
+func (x *Data_Snowflake) GetPrefix() string {
 	if x != nil {
-		return x.Screct
+		return x.Prefix
 	}
 	return ""
 }
 
-type Data_Kafka struct {
+// 连接池配置，未配置的项使用默认值
+type Data_Database_Pool struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addr        []string `protobuf:"bytes,1,rep,name=addr,proto3" json:"addr,omitempty"`
-	SendTopic   []string `protobuf:"bytes,2,rep,name=send_topic,json=sendTopic,proto3" json:"send_topic,omitempty"`
-	ReciveTopic []string `protobuf:"bytes,3,rep,name=recive_topic,json=reciveTopic,proto3" json:"recive_topic,omitempty"`
-	Group       []string `protobuf:"bytes,4,rep,name=group,proto3" json:"group,omitempty"`
+	MaxIdle     int32                `protobuf:"varint,1,opt,name=max_idle,json=maxIdle,proto3" json:"max_idle,omitempty"`
+	MaxOpen     int32                `protobuf:"varint,2,opt,name=max_open,json=maxOpen,proto3" json:"max_open,omitempty"`
+	MaxLifetime *durationpb.Duration `protobuf:"bytes,3,opt,name=max_lifetime,json=maxLifetime,proto3" json:"max_lifetime,omitempty"`
+	MaxIdleTime *durationpb.Duration `protobuf:"bytes,4,opt,name=max_idle_time,json=maxIdleTime,proto3" json:"max_idle_time,omitempty"`
 }
 
-func (x *Data_Kafka) Reset() {
-	*x = Data_Kafka{}
+func (x *Data_Database_Pool) Reset() {
+	*x = Data_Database_Pool{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_user_service_internal_conf_conf_proto_msgTypes[10]
+		mi := &file_app_user_service_internal_conf_conf_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Data_Kafka) String() string {
+func (x *Data_Database_Pool) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data_Kafka) ProtoMessage() {}
+func (*Data_Database_Pool) ProtoMessage() {}
 
-func (x *Data_Kafka) ProtoReflect() protoreflect.Message {
-	mi := &file_app_user_service_internal_conf_conf_proto_msgTypes[10]
+func (x *Data_Database_Pool) ProtoReflect() protoreflect.Message {
+	mi := &file_app_user_service_internal_conf_conf_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,65 +887,65 @@ func (x *Data_Kafka) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data_Kafka.ProtoReflect.Descriptor instead.
-func (*Data_Kafka) Descriptor() ([]byte, []int) {
-	return file_app_user_service_internal_conf_conf_proto_rawDescGZIP(), []int{3, 3}
+// Deprecated: Use Data_Database_Pool.ProtoReflect.Descriptor instead.
+func (*Data_Database_Pool) Descriptor() ([]byte, []int) {
+	return file_app_user_service_internal_conf_conf_proto_rawDescGZIP(), []int{3, 0, 0}
 }
 
-func (x *Data_Kafka) GetAddr() []string {
+func (x *Data_Database_Pool) GetMaxIdle() int32 {
 	if x != nil {
-		return x.Addr
+		return x.MaxIdle
 	}
-	return nil
+	return 0
 }
 
-func (x *Data_Kafka) GetSendTopic() []string {
+func (x *Data_Database_Pool) GetMaxOpen() int32 {
 	if x != nil {
-		return x.SendTopic
+		return x.MaxOpen
 	}
-	return nil
+	return 0
 }
 
-func (x *Data_Kafka) GetReciveTopic() []string {
+func (x *Data_Database_Pool) GetMaxLifetime() *durationpb.Duration {
 	if x != nil {
-		return x.ReciveTopic
+		return x.MaxLifetime
 	}
 	return nil
 }
 
-func (x *Data_Kafka) GetGroup() []string {
+func (x *Data_Database_Pool) GetMaxIdleTime() *durationpb.Duration {
 	if x != nil {
-		return x.Group
+		return x.MaxIdleTime
 	}
 	return nil
 }
 
-type Registry_Nacos struct {
+type Data_Database_Replica struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Port    int64  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Source string              `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Pool   *Data_Database_Pool `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
 }
 
-func (x *Registry_Nacos) Reset() {
-	*x = Registry_Nacos{}
+func (x *Data_Database_Replica) Reset() {
+	*x = Data_Database_Replica{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_user_service_internal_conf_conf_proto_msgTypes[11]
+		mi := &file_app_user_service_internal_conf_conf_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Registry_Nacos) String() string {
+func (x *Data_Database_Replica) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Registry_Nacos) ProtoMessage() {}
+func (*Data_Database_Replica) ProtoMessage() {}
 
-func (x *Registry_Nacos) ProtoReflect() protoreflect.Message {
-	mi := &file_app_user_service_internal_conf_conf_proto_msgTypes[11]
+func (x *Data_Database_Replica) ProtoReflect() protoreflect.Message {
+	mi := &file_app_user_service_internal_conf_conf_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -766,23 +956,23 @@ func (x *Registry_Nacos) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Registry_Nacos.ProtoReflect.Descriptor instead.
-func (*Registry_Nacos) Descriptor() ([]byte, []int) {
-	return file_app_user_service_internal_conf_conf_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use Data_Database_Replica.ProtoReflect.Descriptor instead.
+func (*Data_Database_Replica) Descriptor() ([]byte, []int) {
+	return file_app_user_service_internal_conf_conf_proto_rawDescGZIP(), []int{3, 0, 1}
 }
 
-func (x *Registry_Nacos) GetAddress() string {
+func (x *Data_Database_Replica) GetSource() string {
 	if x != nil {
-		return x.Address
+		return x.Source
 	}
 	return ""
 }
 
-func (x *Registry_Nacos) GetPort() int64 {
+func (x *Data_Database_Replica) GetPool() *Data_Database_Pool {
 	if x != nil {
-		return x.Port
+		return x.Pool
 	}
-	return 0
+	return nil
 }
 
 var File_app_user_service_internal_conf_conf_proto protoreflect.FileDescriptor
@@ -793,95 +983,159 @@ var file_app_user_service_internal_conf_conf_proto_rawDesc = []byte{
 	0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x75, 0x73, 0x65,
 	0x72, 0x2e, 0x61, 0x70, 0x69, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x01, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
-	0x72, 0x61, 0x70, 0x12, 0x25, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72,
-	0x61, 0x63, 0x65, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x65,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x70, 0x6b, 0x67, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x79, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x70, 0x6b, 0x67,
+	0x2f, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x2f, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x72, 0x61, 0x63,
+	0x69, 0x6e, 0x67, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xa7, 0x02, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x12,
+	0x29, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x65,
 	0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x75, 0x73, 0x65,
 	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65,
 	0x72, 0x76, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x23, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x63,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0xb4, 0x02,
-	0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x52, 0x04, 0x68,
-	0x74, 0x74, 0x70, 0x12, 0x29, 0x0a, 0x04, 0x67, 0x72, 0x70, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x52, 0x04, 0x67, 0x72, 0x70, 0x63, 0x1a, 0x69,
-	0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x61, 0x64, 0x64, 0x72, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x69, 0x0a, 0x04, 0x47, 0x52, 0x50,
-	0x43, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x61,
-	0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12,
-	0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x22, 0xf3, 0x05, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x33, 0x0a,
-	0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x2e,
-	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61, 0x74,
-	0x61, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x73, 0x52, 0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x12, 0x2d,
-	0x0a, 0x06, 0x61, 0x70, 0x6f, 0x6c, 0x6c, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x41,
-	0x70, 0x6f, 0x6c, 0x6c, 0x6f, 0x52, 0x06, 0x61, 0x70, 0x6f, 0x6c, 0x6c, 0x6f, 0x12, 0x2a, 0x0a,
+	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x6c, 0x6f, 0x67, 0x67,
+	0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12,
+	0x2a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6b,
+	0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x22, 0x1f, 0x0a, 0x04, 0x41,
+	0x75, 0x74, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x6a, 0x77, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6a, 0x77, 0x74, 0x4b, 0x65, 0x79, 0x22, 0xb4, 0x02, 0x0a,
+	0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x52, 0x04, 0x68, 0x74,
+	0x74, 0x70, 0x12, 0x29, 0x0a, 0x04, 0x67, 0x72, 0x70, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x52, 0x04, 0x67, 0x72, 0x70, 0x63, 0x1a, 0x69, 0x0a,
+	0x04, 0x48, 0x54, 0x54, 0x50, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61,
+	0x64, 0x64, 0x72, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x69, 0x0a, 0x04, 0x47, 0x52, 0x50, 0x43,
+	0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x33,
+	0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x22, 0x83, 0x0b, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x33, 0x0a, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x2e, 0x52, 0x65, 0x64, 0x69, 0x73, 0x52, 0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x12, 0x2a, 0x0a,
 	0x05, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x75,
 	0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x4b, 0x61, 0x66,
-	0x6b, 0x61, 0x52, 0x05, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x1a, 0x3a, 0x0a, 0x08, 0x44, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0xf7, 0x01, 0x0a, 0x05, 0x52, 0x65, 0x64, 0x69, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x3c, 0x0a,
-	0x0c, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
-	0x72, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x77,
-	0x72, 0x69, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x77,
-	0x72, 0x69, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x6f, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12,
-	0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61,
-	0x75, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x1a,
-	0x83, 0x01, 0x0a, 0x06, 0x41, 0x70, 0x6f, 0x6c, 0x6c, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64,
-	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x15,
-	0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12,
-	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x63, 0x72, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x63, 0x72, 0x65, 0x63, 0x74, 0x1a, 0x73, 0x0a, 0x05, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x12, 0x12,
-	0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64,
-	0x64, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x70, 0x69,
-	0x63, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x76, 0x65, 0x54,
-	0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x71, 0x0a, 0x08, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x6e, 0x61, 0x63, 0x6f, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x4e, 0x61, 0x63, 0x6f, 0x73, 0x52,
-	0x05, 0x6e, 0x61, 0x63, 0x6f, 0x73, 0x1a, 0x35, 0x0a, 0x05, 0x4e, 0x61, 0x63, 0x6f, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x2b, 0x5a,
-	0x29, 0x63, 0x61, 0x73, 0x73, 0x6f, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x3b, 0x63, 0x6f, 0x6e, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x6b, 0x61, 0x52, 0x05, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x12, 0x36, 0x0a, 0x09, 0x73, 0x6e, 0x6f,
+	0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x6e, 0x6f,
+	0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x09, 0x73, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b,
+	0x65, 0x1a, 0xb5, 0x05, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x69, 0x73, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x69, 0x73, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x04,
+	0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x3b,
+	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x79, 0x6f, 0x75, 0x72, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x59, 0x6f, 0x75, 0x72, 0x57, 0x72, 0x69, 0x74, 0x65, 0x73,
+	0x12, 0x3c, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x6c, 0x6f, 0x77, 0x5f, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6c, 0x6f, 0x77, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x1a, 0xb9, 0x01, 0x0a, 0x04, 0x50, 0x6f, 0x6f,
+	0x6c, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x6d, 0x61, 0x78, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x3c, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x6c,
+	0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4c, 0x69, 0x66,
+	0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x6c,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x1a, 0x53, 0x0a, 0x07, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x50,
+	0x6f, 0x6f, 0x6c, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x1a, 0xf7, 0x01, 0x0a, 0x05, 0x52, 0x65,
+	0x64, 0x69, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a,
+	0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64,
+	0x72, 0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x3e, 0x0a, 0x0d, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x77, 0x72, 0x69, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70,
+	0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x1a, 0x73, 0x0a, 0x05, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x88, 0x01, 0x0a, 0x09, 0x53, 0x6e, 0x6f,
+	0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x64,
+	0x65, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x74, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x74, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0xd3, 0x01, 0x0a, 0x08, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x12, 0x29, 0x0a, 0x05, 0x6e, 0x61, 0x63, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x4e,
+	0x61, 0x63, 0x6f, 0x73, 0x52, 0x05, 0x6e, 0x61, 0x63, 0x6f, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x65,
+	0x74, 0x63, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x52, 0x04, 0x65,
+	0x74, 0x63, 0x64, 0x12, 0x2c, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x73, 0x75,
+	0x6c, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x6b, 0x67, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x42,
+	0x2b, 0x5a, 0x29, 0x63, 0x61, 0x73, 0x73, 0x6f, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x75, 0x73, 0x65,
+	0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x3b, 0x63, 0x6f, 0x6e, 0x66, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -896,42 +1150,67 @@ func file_app_user_service_internal_conf_conf_proto_rawDescGZIP() []byte {
 	return file_app_user_service_internal_conf_conf_proto_rawDescData
 }
 
-var file_app_user_service_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_app_user_service_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_app_user_service_internal_conf_conf_proto_goTypes = []interface{}{
-	(*Bootstrap)(nil),           // 0: user.api.Bootstrap
-	(*Trace)(nil),               // 1: user.api.Trace
-	(*Server)(nil),              // 2: user.api.Server
-	(*Data)(nil),                // 3: user.api.Data
-	(*Registry)(nil),            // 4: user.api.Registry
-	(*Server_HTTP)(nil),         // 5: user.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 6: user.api.Server.GRPC
-	(*Data_Database)(nil),       // 7: user.api.Data.Database
-	(*Data_Redis)(nil),          // 8: user.api.Data.Redis
-	(*Data_Apollo)(nil),         // 9: user.api.Data.Apollo
-	(*Data_Kafka)(nil),          // 10: user.api.Data.Kafka
-	(*Registry_Nacos)(nil),      // 11: user.api.Registry.Nacos
-	(*durationpb.Duration)(nil), // 12: google.protobuf.Duration
+	(*Bootstrap)(nil),             // 0: user.api.Bootstrap
+	(*Auth)(nil),                  // 1: user.api.Auth
+	(*Server)(nil),                // 2: user.api.Server
+	(*Data)(nil),                  // 3: user.api.Data
+	(*Registry)(nil),              // 4: user.api.Registry
+	(*Server_HTTP)(nil),           // 5: user.api.Server.HTTP
+	(*Server_GRPC)(nil),           // 6: user.api.Server.GRPC
+	(*Data_Database)(nil),         // 7: user.api.Data.Database
+	(*Data_Redis)(nil),            // 8: user.api.Data.Redis
+	(*Data_Kafka)(nil),            // 9: user.api.Data.Kafka
+	(*Data_Snowflake)(nil),        // 10: user.api.Data.Snowflake
+	(*Data_Database_Pool)(nil),    // 11: user.api.Data.Database.Pool
+	(*Data_Database_Replica)(nil), // 12: user.api.Data.Database.Replica
+	(*tracing.Config)(nil),        // 13: pkg.tracing.Config
+	(*logging.Config)(nil),        // 14: pkg.logging.Config
+	(*config.Remote)(nil),         // 15: pkg.config.Remote
+	(*config.Secret)(nil),         // 16: pkg.config.Secret
+	(*registry.Nacos)(nil),        // 17: pkg.registry.Nacos
+	(*registry.Etcd)(nil),         // 18: pkg.registry.Etcd
+	(*registry.Consul)(nil),       // 19: pkg.registry.Consul
+	(*registry.Static)(nil),       // 20: pkg.registry.Static
+	(*durationpb.Duration)(nil),   // 21: google.protobuf.Duration
 }
 var file_app_user_service_internal_conf_conf_proto_depIdxs = []int32{
-	1,  // 0: user.api.Bootstrap.trace:type_name -> user.api.Trace
+	13, // 0: user.api.Bootstrap.trace:type_name -> pkg.tracing.Config
 	2,  // 1: user.api.Bootstrap.server:type_name -> user.api.Server
 	3,  // 2: user.api.Bootstrap.data:type_name -> user.api.Data
-	5,  // 3: user.api.Server.http:type_name -> user.api.Server.HTTP
-	6,  // 4: user.api.Server.grpc:type_name -> user.api.Server.GRPC
-	7,  // 5: user.api.Data.database:type_name -> user.api.Data.Database
-	8,  // 6: user.api.Data.redis:type_name -> user.api.Data.Redis
-	9,  // 7: user.api.Data.apollo:type_name -> user.api.Data.Apollo
-	10, // 8: user.api.Data.kafka:type_name -> user.api.Data.Kafka
-	11, // 9: user.api.Registry.nacos:type_name -> user.api.Registry.Nacos
-	12, // 10: user.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	12, // 11: user.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	12, // 12: user.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	12, // 13: user.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	14, // 3: user.api.Bootstrap.log:type_name -> pkg.logging.Config
+	15, // 4: user.api.Bootstrap.config:type_name -> pkg.config.Remote
+	16, // 5: user.api.Bootstrap.secret:type_name -> pkg.config.Secret
+	1,  // 6: user.api.Bootstrap.auth:type_name -> user.api.Auth
+	5,  // 7: user.api.Server.http:type_name -> user.api.Server.HTTP
+	6,  // 8: user.api.Server.grpc:type_name -> user.api.Server.GRPC
+	7,  // 9: user.api.Data.database:type_name -> user.api.Data.Database
+	8,  // 10: user.api.Data.redis:type_name -> user.api.Data.Redis
+	9,  // 11: user.api.Data.kafka:type_name -> user.api.Data.Kafka
+	10, // 12: user.api.Data.snowflake:type_name -> user.api.Data.Snowflake
+	17, // 13: user.api.Registry.nacos:type_name -> pkg.registry.Nacos
+	18, // 14: user.api.Registry.etcd:type_name -> pkg.registry.Etcd
+	19, // 15: user.api.Registry.consul:type_name -> pkg.registry.Consul
+	20, // 16: user.api.Registry.static:type_name -> pkg.registry.Static
+	21, // 17: user.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	21, // 18: user.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	11, // 19: user.api.Data.Database.pool:type_name -> user.api.Data.Database.Pool
+	12, // 20: user.api.Data.Database.replicas:type_name -> user.api.Data.Database.Replica
+	21, // 21: user.api.Data.Database.read_your_writes:type_name -> google.protobuf.Duration
+	21, // 22: user.api.Data.Database.health_check:type_name -> google.protobuf.Duration
+	21, // 23: user.api.Data.Database.slow_threshold:type_name -> google.protobuf.Duration
+	21, // 24: user.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	21, // 25: user.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	21, // 26: user.api.Data.Snowflake.lease_ttl:type_name -> google.protobuf.Duration
+	21, // 27: user.api.Data.Database.Pool.max_lifetime:type_name -> google.protobuf.Duration
+	21, // 28: user.api.Data.Database.Pool.max_idle_time:type_name -> google.protobuf.Duration
+	11, // 29: user.api.Data.Database.Replica.pool:type_name -> user.api.Data.Database.Pool
+	30, // [30:30] is the sub-list for method output_type
+	30, // [30:30] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_app_user_service_internal_conf_conf_proto_init() }
@@ -953,7 +1232,7 @@ func file_app_user_service_internal_conf_conf_proto_init() {
 			}
 		}
 		file_app_user_service_internal_conf_conf_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Trace); i {
+			switch v := v.(*Auth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1049,7 +1328,7 @@ func file_app_user_service_internal_conf_conf_proto_init() {
 			}
 		}
 		file_app_user_service_internal_conf_conf_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Data_Apollo); i {
+			switch v := v.(*Data_Kafka); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1061,7 +1340,7 @@ func file_app_user_service_internal_conf_conf_proto_init() {
 			}
 		}
 		file_app_user_service_internal_conf_conf_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Data_Kafka); i {
+			switch v := v.(*Data_Snowflake); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1073,7 +1352,19 @@ func file_app_user_service_internal_conf_conf_proto_init() {
 			}
 		}
 		file_app_user_service_internal_conf_conf_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Registry_Nacos); i {
+			switch v := v.(*Data_Database_Pool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_user_service_internal_conf_conf_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Data_Database_Replica); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1091,7 +1382,7 @@ func file_app_user_service_internal_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_app_user_service_internal_conf_conf_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,75 @@
+package conf
+
+import (
+	"casso/pkg/registry"
+	"casso/pkg/util/snowflake"
+	"casso/pkg/util/transaction"
+	"errors"
+	"fmt"
+)
+
+// 雪花发号器节点ID的来源
+const (
+	SnowflakeLease  = "lease" // 默认
+	SnowflakeStatic = "static"
+)
+
+// Validate 由 pkg/config 在加载、重新加载时调用，不合法的配置不生效
+func (x *Bootstrap) Validate() error {
+	if x.GetServer().GetGrpc().GetAddr() == "" {
+		return errors.New("conf: server.grpc.addr is required")
+	}
+	db := x.GetData().GetDatabase()
+	if db.GetSource() == "" {
+		return errors.New("conf: data.database.source is required")
+	}
+	if _, err := transaction.ParseIsolation(db.GetIsolation()); err != nil {
+		return fmt.Errorf("conf: data.database.isolation: %w", err)
+	}
+	for i, r := range db.GetReplicas() {
+		if r.GetSource() == "" {
+			return fmt.Errorf("conf: data.database.replicas[%d].source is required", i)
+		}
+	}
+	if x.GetData().GetRedis().GetAddr() == "" {
+		return errors.New("conf: data.redis.addr is required")
+	}
+	sf := x.GetData().GetSnowflake()
+	switch sf.GetMode() {
+	case "", SnowflakeLease:
+	case SnowflakeStatic:
+		if sf.GetNodeId() < 0 || sf.GetNodeId() > snowflake.MaxNode {
+			return fmt.Errorf("conf: data.snowflake.node_id must be between 0 and %d", snowflake.MaxNode)
+		}
+	default:
+		return fmt.Errorf("conf: unknown data.snowflake.mode %q", sf.GetMode())
+	}
+	if len(x.GetAuth().GetJwtKey()) < 16 {
+		return errors.New("conf: auth.jwt_key is required and must be at least 16 bytes")
+	}
+	if err := x.GetTrace().Validate(); err != nil {
+		return err
+	}
+	if err := x.GetConfig().Validate(); err != nil {
+		return err
+	}
+	if err := x.GetSecret().Validate(); err != nil {
+		return err
+	}
+	return x.GetLog().Validate()
+}
+
+// Validate registry.yaml
+func (x *Registry) Validate() error {
+	return registry.Validate(x)
+}
+
+// Validate casso.yaml 的 casso_conf
+func (c CassoConf) Validate() error {
+	for _, item := range c {
+		if item.ID <= 0 || item.Addr == "" {
+			return fmt.Errorf("conf: invalid casso_conf item %+v", item)
+		}
+	}
+	return nil
+}
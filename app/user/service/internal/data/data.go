@@ -9,8 +9,12 @@
 package data
 
 import (
+	"casso/app/user/service/internal/biz"
 	"casso/app/user/service/internal/conf"
-	"fmt"
+	"casso/pkg/dbrouter"
+	"casso/pkg/util/shard"
+	"casso/pkg/util/transaction"
+	"context"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
@@ -22,31 +26,35 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewDB, NewRd, NewUserRepo)
+var ProviderSet = wire.NewSet(NewData, NewDB, NewRouter, NewShardRouter, NewIDGenerator, NewRd, NewUserRepo, NewTransaction, NewHealth)
 
 // Data .
 type Data struct {
-	rd  *redis.Client
-	db  *gorm.DB
-	log *log.Helper
+	rd     *redis.Client
+	db     *gorm.DB
+	router *dbrouter.Router
+	users  *shard.Router // 用户分表路由
+	tm     *transaction.Manager
+	log    *log.Helper
 }
 
 // NewData .
-func NewData(db *gorm.DB, rd *redis.Client, logger log.Logger) (*Data, func(), error) {
+func NewData(c *conf.Data, db *gorm.DB, router *dbrouter.Router, users *shard.Router, rd *redis.Client, logger log.Logger) (*Data, func(), error) {
 	log := log.NewHelper(log.With(logger, "module", "user-service/data"))
 
-	d := &Data{
-		rd:  rd,
-		db:  db,
-		log: log,
+	isolation, err := transaction.ParseIsolation(c.Database.Isolation)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// 监听配置文件并处理
-	go func() {
-		for v := range conf.ConfCh {
-			fmt.Println("初始化自定义配置文件：", v.CassoConf[0].ID, v.CassoConf[0].Addr)
-		}
-	}()
+	d := &Data{
+		rd:     rd,
+		db:     db,
+		router: router,
+		users:  users,
+		tm:     transaction.NewManager(db, transaction.WithDefaultIsolation(isolation)),
+		log:    log,
+	}
 
 	// 启动定时任务
 	go InitTimer(*d)
@@ -68,3 +76,28 @@ func NewData(db *gorm.DB, rd *redis.Client, logger log.Logger) (*Data, func(), e
 		log.Info("resource close successed !")
 	}, nil
 }
+
+// NewTransaction 事务由 data 层实现，biz 层只依赖 biz.Transaction 接口
+func NewTransaction(d *Data) biz.Transaction {
+	return d
+}
+
+// InTx 在事务中执行 fn，fn 内通过 ctx 调用的 repo 方法自动加入同一个事务
+func (d *Data) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return d.tm.InTx(ctx, fn)
+}
+
+// AfterCommit 注册事务提交后的回调，不在事务中时立即执行
+func (d *Data) AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	transaction.AfterCommit(ctx, fn)
+}
+
+// DB 获取写连接（主库），处于事务中时返回事务连接
+func (d *Data) DB(ctx context.Context) *gorm.DB {
+	return d.router.Write(ctx)
+}
+
+// ReadDB 获取读连接，优先走从库；事务内或写后读窗口内走主库
+func (d *Data) ReadDB(ctx context.Context) *gorm.DB {
+	return d.router.Read(ctx)
+}
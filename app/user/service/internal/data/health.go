@@ -0,0 +1,19 @@
+package data
+
+import (
+	"casso/app/user/service/internal/conf"
+	"casso/pkg/health"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewHealth 就绪检查：主库、redis 与 kafka，从库不可用时读请求回退到主库，不影响就绪
+func NewHealth(c *conf.Data, d *Data, logger log.Logger) *health.Health {
+	h := health.New(health.WithLogger(logger))
+	h.Register("mysql", health.GORM(d.db))
+	h.Register("redis", health.Redis(d.rd))
+	if addrs := c.GetKafka().GetAddr(); len(addrs) > 0 {
+		h.Register("kafka", health.TCP(addrs...))
+	}
+	return h
+}
@@ -0,0 +1,13 @@
+-- 用户表，与此前 AutoMigrate 生成的表结构一致，已有库执行时不会重复创建
+CREATE TABLE IF NOT EXISTS `user` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `mobile` varchar(191) DEFAULT NULL COMMENT '手机号',
+  `pass` longtext COMMENT '密码',
+  `name` longtext COMMENT '用户名',
+  `age` bigint DEFAULT NULL COMMENT '年龄',
+  `updated_time` bigint(20) DEFAULT NULL COMMENT '最后修改时间',
+  `created_time` bigint(20) DEFAULT NULL COMMENT '创建时间',
+  `delete_time` bigint(20) DEFAULT NULL COMMENT '删除时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `mobile` (`mobile`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,17 @@
+DROP TABLE IF EXISTS `user_mobile`;
+DROP TABLE IF EXISTS `user_00`;
+DROP TABLE IF EXISTS `user_01`;
+DROP TABLE IF EXISTS `user_02`;
+DROP TABLE IF EXISTS `user_03`;
+DROP TABLE IF EXISTS `user_04`;
+DROP TABLE IF EXISTS `user_05`;
+DROP TABLE IF EXISTS `user_06`;
+DROP TABLE IF EXISTS `user_07`;
+DROP TABLE IF EXISTS `user_08`;
+DROP TABLE IF EXISTS `user_09`;
+DROP TABLE IF EXISTS `user_10`;
+DROP TABLE IF EXISTS `user_11`;
+DROP TABLE IF EXISTS `user_12`;
+DROP TABLE IF EXISTS `user_13`;
+DROP TABLE IF EXISTS `user_14`;
+DROP TABLE IF EXISTS `user_15`;
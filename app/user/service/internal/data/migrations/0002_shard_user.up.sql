@@ -0,0 +1,219 @@
+-- 用户表按雪花ID分为 16 张表，分片规则见 pkg/util/shard（jump hash），与配置 data.database.shards 保持一致
+-- 旧的 user 表保留，数据通过 cmd/reshard backfill 迁移到分表
+
+CREATE TABLE IF NOT EXISTS `user_00` (
+  `id` bigint NOT NULL,
+  `mobile` varchar(32) DEFAULT NULL COMMENT '手机号',
+  `pass` longtext COMMENT '密码',
+  `name` longtext COMMENT '用户名',
+  `age` bigint DEFAULT NULL COMMENT '年龄',
+  `updated_time` bigint(20) DEFAULT NULL COMMENT '最后修改时间',
+  `created_time` bigint(20) DEFAULT NULL COMMENT '创建时间',
+  `delete_time` bigint(20) DEFAULT NULL COMMENT '删除时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `mobile` (`mobile`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_01` (
+  `id` bigint NOT NULL,
+  `mobile` varchar(32) DEFAULT NULL COMMENT '手机号',
+  `pass` longtext COMMENT '密码',
+  `name` longtext COMMENT '用户名',
+  `age` bigint DEFAULT NULL COMMENT '年龄',
+  `updated_time` bigint(20) DEFAULT NULL COMMENT '最后修改时间',
+  `created_time` bigint(20) DEFAULT NULL COMMENT '创建时间',
+  `delete_time` bigint(20) DEFAULT NULL COMMENT '删除时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `mobile` (`mobile`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_02` (
+  `id` bigint NOT NULL,
+  `mobile` varchar(32) DEFAULT NULL COMMENT '手机号',
+  `pass` longtext COMMENT '密码',
+  `name` longtext COMMENT '用户名',
+  `age` bigint DEFAULT NULL COMMENT '年龄',
+  `updated_time` bigint(20) DEFAULT NULL COMMENT '最后修改时间',
+  `created_time` bigint(20) DEFAULT NULL COMMENT '创建时间',
+  `delete_time` bigint(20) DEFAULT NULL COMMENT '删除时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `mobile` (`mobile`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_03` (
+  `id` bigint NOT NULL,
+  `mobile` varchar(32) DEFAULT NULL COMMENT '手机号',
+  `pass` longtext COMMENT '密码',
+  `name` longtext COMMENT '用户名',
+  `age` bigint DEFAULT NULL COMMENT '年龄',
+  `updated_time` bigint(20) DEFAULT NULL COMMENT '最后修改时间',
+  `created_time` bigint(20) DEFAULT NULL COMMENT '创建时间',
+  `delete_time` bigint(20) DEFAULT NULL COMMENT '删除时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `mobile` (`mobile`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_04` (
+  `id` bigint NOT NULL,
+  `mobile` varchar(32) DEFAULT NULL COMMENT '手机号',
+  `pass` longtext COMMENT '密码',
+  `name` longtext COMMENT '用户名',
+  `age` bigint DEFAULT NULL COMMENT '年龄',
+  `updated_time` bigint(20) DEFAULT NULL COMMENT '最后修改时间',
+  `created_time` bigint(20) DEFAULT NULL COMMENT '创建时间',
+  `delete_time` bigint(20) DEFAULT NULL COMMENT '删除时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `mobile` (`mobile`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_05` (
+  `id` bigint NOT NULL,
+  `mobile` varchar(32) DEFAULT NULL COMMENT '手机号',
+  `pass` longtext COMMENT '密码',
+  `name` longtext COMMENT '用户名',
+  `age` bigint DEFAULT NULL COMMENT '年龄',
+  `updated_time` bigint(20) DEFAULT NULL COMMENT '最后修改时间',
+  `created_time` bigint(20) DEFAULT NULL COMMENT '创建时间',
+  `delete_time` bigint(20) DEFAULT NULL COMMENT '删除时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `mobile` (`mobile`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_06` (
+  `id` bigint NOT NULL,
+  `mobile` varchar(32) DEFAULT NULL COMMENT '手机号',
+  `pass` longtext COMMENT '密码',
+  `name` longtext COMMENT '用户名',
+  `age` bigint DEFAULT NULL COMMENT '年龄',
+  `updated_time` bigint(20) DEFAULT NULL COMMENT '最后修改时间',
+  `created_time` bigint(20) DEFAULT NULL COMMENT '创建时间',
+  `delete_time` bigint(20) DEFAULT NULL COMMENT '删除时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `mobile` (`mobile`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_07` (
+  `id` bigint NOT NULL,
+  `mobile` varchar(32) DEFAULT NULL COMMENT '手机号',
+  `pass` longtext COMMENT '密码',
+  `name` longtext COMMENT '用户名',
+  `age` bigint DEFAULT NULL COMMENT '年龄',
+  `updated_time` bigint(20) DEFAULT NULL COMMENT '最后修改时间',
+  `created_time` bigint(20) DEFAULT NULL COMMENT '创建时间',
+  `delete_time` bigint(20) DEFAULT NULL COMMENT '删除时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `mobile` (`mobile`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_08` (
+  `id` bigint NOT NULL,
+  `mobile` varchar(32) DEFAULT NULL COMMENT '手机号',
+  `pass` longtext COMMENT '密码',
+  `name` longtext COMMENT '用户名',
+  `age` bigint DEFAULT NULL COMMENT '年龄',
+  `updated_time` bigint(20) DEFAULT NULL COMMENT '最后修改时间',
+  `created_time` bigint(20) DEFAULT NULL COMMENT '创建时间',
+  `delete_time` bigint(20) DEFAULT NULL COMMENT '删除时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `mobile` (`mobile`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_09` (
+  `id` bigint NOT NULL,
+  `mobile` varchar(32) DEFAULT NULL COMMENT '手机号',
+  `pass` longtext COMMENT '密码',
+  `name` longtext COMMENT '用户名',
+  `age` bigint DEFAULT NULL COMMENT '年龄',
+  `updated_time` bigint(20) DEFAULT NULL COMMENT '最后修改时间',
+  `created_time` bigint(20) DEFAULT NULL COMMENT '创建时间',
+  `delete_time` bigint(20) DEFAULT NULL COMMENT '删除时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `mobile` (`mobile`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_10` (
+  `id` bigint NOT NULL,
+  `mobile` varchar(32) DEFAULT NULL COMMENT '手机号',
+  `pass` longtext COMMENT '密码',
+  `name` longtext COMMENT '用户名',
+  `age` bigint DEFAULT NULL COMMENT '年龄',
+  `updated_time` bigint(20) DEFAULT NULL COMMENT '最后修改时间',
+  `created_time` bigint(20) DEFAULT NULL COMMENT '创建时间',
+  `delete_time` bigint(20) DEFAULT NULL COMMENT '删除时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `mobile` (`mobile`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_11` (
+  `id` bigint NOT NULL,
+  `mobile` varchar(32) DEFAULT NULL COMMENT '手机号',
+  `pass` longtext COMMENT '密码',
+  `name` longtext COMMENT '用户名',
+  `age` bigint DEFAULT NULL COMMENT '年龄',
+  `updated_time` bigint(20) DEFAULT NULL COMMENT '最后修改时间',
+  `created_time` bigint(20) DEFAULT NULL COMMENT '创建时间',
+  `delete_time` bigint(20) DEFAULT NULL COMMENT '删除时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `mobile` (`mobile`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_12` (
+  `id` bigint NOT NULL,
+  `mobile` varchar(32) DEFAULT NULL COMMENT '手机号',
+  `pass` longtext COMMENT '密码',
+  `name` longtext COMMENT '用户名',
+  `age` bigint DEFAULT NULL COMMENT '年龄',
+  `updated_time` bigint(20) DEFAULT NULL COMMENT '最后修改时间',
+  `created_time` bigint(20) DEFAULT NULL COMMENT '创建时间',
+  `delete_time` bigint(20) DEFAULT NULL COMMENT '删除时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `mobile` (`mobile`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_13` (
+  `id` bigint NOT NULL,
+  `mobile` varchar(32) DEFAULT NULL COMMENT '手机号',
+  `pass` longtext COMMENT '密码',
+  `name` longtext COMMENT '用户名',
+  `age` bigint DEFAULT NULL COMMENT '年龄',
+  `updated_time` bigint(20) DEFAULT NULL COMMENT '最后修改时间',
+  `created_time` bigint(20) DEFAULT NULL COMMENT '创建时间',
+  `delete_time` bigint(20) DEFAULT NULL COMMENT '删除时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `mobile` (`mobile`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_14` (
+  `id` bigint NOT NULL,
+  `mobile` varchar(32) DEFAULT NULL COMMENT '手机号',
+  `pass` longtext COMMENT '密码',
+  `name` longtext COMMENT '用户名',
+  `age` bigint DEFAULT NULL COMMENT '年龄',
+  `updated_time` bigint(20) DEFAULT NULL COMMENT '最后修改时间',
+  `created_time` bigint(20) DEFAULT NULL COMMENT '创建时间',
+  `delete_time` bigint(20) DEFAULT NULL COMMENT '删除时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `mobile` (`mobile`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `user_15` (
+  `id` bigint NOT NULL,
+  `mobile` varchar(32) DEFAULT NULL COMMENT '手机号',
+  `pass` longtext COMMENT '密码',
+  `name` longtext COMMENT '用户名',
+  `age` bigint DEFAULT NULL COMMENT '年龄',
+  `updated_time` bigint(20) DEFAULT NULL COMMENT '最后修改时间',
+  `created_time` bigint(20) DEFAULT NULL COMMENT '创建时间',
+  `delete_time` bigint(20) DEFAULT NULL COMMENT '删除时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `mobile` (`mobile`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- 手机号索引表，保证手机号全局唯一
+CREATE TABLE IF NOT EXISTS `user_mobile` (
+  `mobile` varchar(32) NOT NULL COMMENT '手机号',
+  `user_id` bigint NOT NULL COMMENT '用户ID',
+  `created_time` bigint(20) DEFAULT NULL COMMENT '创建时间',
+  PRIMARY KEY (`mobile`),
+  KEY `idx_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
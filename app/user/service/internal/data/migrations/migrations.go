@@ -0,0 +1,7 @@
+// Package migrations 用户服务的数据库迁移文件，新增表结构变更请添加新的版本文件，不要修改已发布的版本
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
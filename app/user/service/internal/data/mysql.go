@@ -2,37 +2,128 @@ package data
 
 import (
 	"casso/app/user/service/internal/conf"
-	"casso/app/user/service/internal/model"
+	"casso/pkg/dbrouter"
+	"casso/pkg/logging"
+	"casso/pkg/metrics"
+	"casso/pkg/tracing"
+	"fmt"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
+	gormlogger "gorm.io/gorm/logger"
 )
 
-func NewDB(conf *conf.Data, loggers log.Logger) *gorm.DB {
+// NewDB 主库连接
+func NewDB(conf *conf.Data, mp *metrics.Prometheus, tp *tracesdk.TracerProvider, loggers log.Logger) *gorm.DB {
 	log := log.NewHelper(log.With(loggers, "module", "user-service/data/gorm"))
-	db, err := gorm.Open(mysql.Open(conf.Database.Source), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info), // 以Infos 形式输出SQL语句
-	})
-
+	db, err := openDB(conf.Database.Source, conf.Database.Pool, gormLogger(conf, loggers))
 	if err != nil {
 		log.Fatalf("failed opening connection to mysql: %v", err)
 	}
+	if err := mp.GORM(db, "primary"); err != nil {
+		log.Fatalf("failed registering mysql metrics: %v", err)
+	}
+	if err := tracing.GORM(db, tp, "primary"); err != nil {
+		log.Fatalf("failed registering mysql tracing: %v", err)
+	}
 
-	sqlDB, err := db.DB() // 维护链接池
+	// 表结构由 cmd/migrate 维护，启动时不再 AutoMigrate
+	return db
+}
+
+// NewRouter 读写分离路由，从库连接由路由负责关闭
+func NewRouter(conf *conf.Data, db *gorm.DB, mp *metrics.Prometheus, tp *tracesdk.TracerProvider, loggers log.Logger) (*dbrouter.Router, func(), error) {
+	log := log.NewHelper(log.With(loggers, "module", "user-service/data/router"))
+
+	var replicas []*gorm.DB
+	for i, r := range conf.Database.Replicas {
+		rdb, err := openDB(r.Source, r.Pool, gormLogger(conf, loggers))
+		if err == nil {
+			replicas = append(replicas, rdb)
+			name := fmt.Sprintf("replica-%d", i)
+			if err = mp.GORM(rdb, name); err == nil {
+				err = tracing.GORM(rdb, tp, name)
+			}
+		}
+		if err != nil {
+			for _, opened := range replicas {
+				if sqlDB, err := opened.DB(); err == nil {
+					sqlDB.Close()
+				}
+			}
+			return nil, nil, err
+		}
+	}
+
+	opts := []dbrouter.Option{
+		dbrouter.WithOnStateChange(func(index int, healthy bool, err error) {
+			if healthy {
+				log.Infof("mysql replica %d recovered", index)
+				return
+			}
+			log.Errorf("mysql replica %d ejected: %v", index, err)
+		}),
+	}
+	if conf.Database.ReadYourWrites != nil {
+		opts = append(opts, dbrouter.WithReadYourWrites(conf.Database.ReadYourWrites.AsDuration()))
+	}
+	if conf.Database.HealthCheck != nil {
+		opts = append(opts, dbrouter.WithHealthCheck(conf.Database.HealthCheck.AsDuration(), time.Second))
+	}
+
+	router := dbrouter.New(db, replicas, opts...)
+	return router, func() {
+		if err := router.Close(); err != nil {
+			log.Errorf("mysql replicas closing resource got fail: %v", err)
+		}
+	}, nil
+}
+
+func openDB(source string, pool *conf.Data_Database_Pool, gl gormlogger.Interface) (*gorm.DB, error) {
+	db, err := gorm.Open(mysql.Open(source), &gorm.Config{
+		Logger: gl, // 只输出失败与慢 SQL，db.Debug() 输出全部
+	})
 	if err != nil {
-		db.Statement.ReflectValue.Close()
-		log.Fatalf("failed making connection-pool: %v", err)
+		return nil, err
 	}
 
-	sqlDB.SetMaxIdleConns(10)           // 空闲最大数量
-	sqlDB.SetMaxOpenConns(100)          // 最大链接数
-	sqlDB.SetConnMaxLifetime(time.Hour) // 最大可复用时间
+	sqlDB, err := db.DB() // 维护链接池
+	if err != nil {
+		return nil, err
+	}
 
-	if err := db.AutoMigrate(&model.User{}); err != nil {
-		log.Fatal(err)
+	// 默认值与原先写死的配置一致
+	var (
+		maxIdle     = 10        // 空闲最大数量
+		maxOpen     = 100       // 最大链接数
+		maxLifetime = time.Hour // 最大可复用时间
+		maxIdleTime time.Duration
+	)
+	if pool != nil {
+		if pool.MaxIdle > 0 {
+			maxIdle = int(pool.MaxIdle)
+		}
+		if pool.MaxOpen > 0 {
+			maxOpen = int(pool.MaxOpen)
+		}
+		if pool.MaxLifetime != nil {
+			maxLifetime = pool.MaxLifetime.AsDuration()
+		}
+		if pool.MaxIdleTime != nil {
+			maxIdleTime = pool.MaxIdleTime.AsDuration()
+		}
 	}
-	return db
+	sqlDB.SetMaxIdleConns(maxIdle)
+	sqlDB.SetMaxOpenConns(maxOpen)
+	sqlDB.SetConnMaxLifetime(maxLifetime)
+	sqlDB.SetConnMaxIdleTime(maxIdleTime)
+	return db, nil
+}
+
+// gormLogger SQL 日志输出到服务的 logger，带有 trace_id
+func gormLogger(conf *conf.Data, loggers log.Logger) gormlogger.Interface {
+	return logging.GORM(log.With(loggers, "module", "user-service/data/sql"), conf.Database.SlowThreshold.AsDuration())
 }
@@ -2,13 +2,16 @@ package data
 
 import (
 	"casso/app/user/service/internal/conf"
+	"casso/pkg/metrics"
+	"casso/pkg/tracing"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 )
 
-func NewRd(conf *conf.Data, logger log.Logger) *redis.Client {
-	log.NewHelper(log.With(logger, "module", "user-service/data/redis"))
+func NewRd(conf *conf.Data, mp *metrics.Prometheus, tp *tracesdk.TracerProvider, logger log.Logger) *redis.Client {
+	log := log.NewHelper(log.With(logger, "module", "user-service/data/redis"))
 	opts := redis.Options{
 		Addr:         conf.Redis.Addr,
 		Username:     conf.Redis.Auth,
@@ -18,5 +21,10 @@ func NewRd(conf *conf.Data, logger log.Logger) *redis.Client {
 		PoolSize:     int(conf.Redis.Pool),
 		DB:           0,
 	}
-	return redis.NewClient(&opts)
+	rd := redis.NewClient(&opts)
+	if err := mp.Redis(rd, "default"); err != nil {
+		log.Errorf("failed registering redis metrics: %v", err)
+	}
+	tracing.Redis(rd, tp)
+	return rd
 }
@@ -0,0 +1,123 @@
+package data
+
+import (
+	"casso/app/user/service/internal/model"
+	"casso/pkg/util/shard"
+	"context"
+	"fmt"
+	"io"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Resharder 用户分表数据迁移，供 cmd/reshard 使用
+// 按ID游标分批执行，每批一个事务；目标表已存在的记录会被跳过，中断后可重复执行
+type Resharder struct {
+	DB     *gorm.DB
+	Batch  int
+	DryRun bool
+	Out    io.Writer
+}
+
+// Backfill 将旧的单表 user 数据复制到分表并建立手机号索引，旧表数据保留
+func (r *Resharder) Backfill(ctx context.Context, to *shard.Router) (int64, error) {
+	var cursor, total int64
+	for {
+		var users []*model.User
+		err := r.DB.WithContext(ctx).Table(model.UserTableName).
+			Where("id > ?", cursor).Order("id").Limit(r.batch()).Find(&users).Error
+		if err != nil {
+			return total, err
+		}
+		if len(users) == 0 {
+			return total, nil
+		}
+		cursor = users[len(users)-1].ID
+
+		groups := make(map[string][]*model.User)
+		var mobiles []*model.UserMobile
+		for _, u := range users {
+			table := to.Table(u.ID)
+			groups[table] = append(groups[table], u)
+			if u.Mobile != "" {
+				mobiles = append(mobiles, &model.UserMobile{Mobile: u.Mobile, UserID: u.ID, CreatedTime: u.CreatedTime})
+			}
+		}
+		if !r.DryRun {
+			err = r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+				for table, us := range groups {
+					if err := tx.Table(table).Clauses(clause.OnConflict{DoNothing: true}).Create(&us).Error; err != nil {
+						return err
+					}
+				}
+				if len(mobiles) == 0 {
+					return nil
+				}
+				return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&mobiles).Error
+			})
+			if err != nil {
+				return total, err
+			}
+		}
+		total += int64(len(users))
+		fmt.Fprintf(r.Out, "backfill %s: %d rows (cursor %d)\n", model.UserTableName, total, cursor)
+	}
+}
+
+// Reshard 按新的分片数重新分布数据，只迁移分片发生变化的记录（插入目标表后从源表删除）
+// 扩容时 jump hash 保证记录只会迁往新增的分表，执行前需先通过迁移创建新分表
+func (r *Resharder) Reshard(ctx context.Context, from, to *shard.Router) (int64, error) {
+	var total int64
+	for i := 0; i < from.Shards(); i++ {
+		source := from.TableAt(i)
+		var cursor int64
+		for {
+			var users []*model.User
+			err := r.DB.WithContext(ctx).Table(source).
+				Where("id > ?", cursor).Order("id").Limit(r.batch()).Find(&users).Error
+			if err != nil {
+				return total, err
+			}
+			if len(users) == 0 {
+				break
+			}
+			cursor = users[len(users)-1].ID
+
+			groups := make(map[string][]*model.User)
+			var ids []int64
+			for _, u := range users {
+				if table := to.Table(u.ID); table != source {
+					groups[table] = append(groups[table], u)
+					ids = append(ids, u.ID)
+				}
+			}
+			if len(ids) == 0 {
+				continue
+			}
+			if !r.DryRun {
+				err = r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+					for table, us := range groups {
+						if err := tx.Table(table).Clauses(clause.OnConflict{DoNothing: true}).Create(&us).Error; err != nil {
+							return err
+						}
+					}
+					return tx.Table(source).Where("id IN ?", ids).Delete(&model.User{}).Error
+				})
+				if err != nil {
+					return total, err
+				}
+			}
+			total += int64(len(ids))
+			fmt.Fprintf(r.Out, "reshard %s: moved %d rows (cursor %d)\n", source, len(ids), cursor)
+		}
+	}
+	return total, nil
+}
+
+func (r *Resharder) batch() int {
+	if r.Batch <= 0 {
+		return 500
+	}
+	return r.Batch
+}
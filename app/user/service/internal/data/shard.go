@@ -0,0 +1,19 @@
+package data
+
+import (
+	"casso/app/user/service/internal/conf"
+	"casso/app/user/service/internal/model"
+	"casso/pkg/util/shard"
+)
+
+// defaultShards 与 0002_shard_user 迁移创建的分表数量一致
+const defaultShards = 16
+
+// NewShardRouter 用户分表路由
+func NewShardRouter(conf *conf.Data) *shard.Router {
+	n := int(conf.Database.Shards)
+	if n <= 0 {
+		n = defaultShards
+	}
+	return shard.NewRouter(model.UserTableName, n)
+}
@@ -0,0 +1,59 @@
+package data
+
+import (
+	"casso/app/user/service/internal/conf"
+	"casso/pkg/util/snowflake"
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+// NewIDGenerator 雪花发号器：mode 为 static 时使用配置的节点ID（可以为 0），否则从 redis 租用节点ID，租用失败时启动失败
+func NewIDGenerator(cd *conf.Data, rd *redis.Client, logger log.Logger) (snowflake.IDGenerator, func(), error) {
+	log := log.NewHelper(log.With(logger, "module", "user-service/data/snowflake"))
+	c := cd.Snowflake
+	if c == nil {
+		c = &conf.Data_Snowflake{}
+	}
+
+	if c.Mode == conf.SnowflakeStatic {
+		node, err := snowflake.NewNode(c.NodeId)
+		if err != nil {
+			return nil, nil, err
+		}
+		log.Infof("snowflake static node id: %d", c.NodeId)
+		return node, func() {}, nil
+	}
+
+	ttl := 30 * time.Second
+	if c.LeaseTtl != nil {
+		ttl = c.LeaseTtl.AsDuration()
+	}
+	prefix := c.Prefix
+	if prefix == "" {
+		prefix = "casso.user.service:snowflake"
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	g, err := snowflake.NewGenerator(ctx, snowflake.NewRedisLeaser(rd, prefix, ttl), []snowflake.GeneratorOption{
+		snowflake.WithTTL(ttl),
+		snowflake.WithOnError(func(err error) {
+			log.Errorf("snowflake lease renew fail: %v", err)
+		}),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	log.Infof("snowflake leased node id: %d", g.NodeID())
+
+	return g, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		if err := g.Close(ctx); err != nil {
+			log.Errorf("snowflake lease release fail: %v", err)
+		}
+	}, nil
+}
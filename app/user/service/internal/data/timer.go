@@ -1,8 +1,6 @@
 package data
 
 import (
-	"fmt"
-
 	"github.com/robfig/cron"
 )
 
@@ -13,7 +11,7 @@ type ChangedShopLog struct {
 }
 
 func (c *ChangedShopLog) Run() {
-	fmt.Println("定时任务:cron")
+	c.Data.log.Debug("定时任务:cron")
 }
 
 func InitTimer(data Data) {
@@ -9,43 +9,85 @@
 package data
 
 import (
+	v1 "casso/api/user/service/v1"
 	"casso/app/user/service/internal/biz"
 	"casso/app/user/service/internal/model"
 	"casso/app/user/service/internal/pkg/utill/passmd5"
+	"casso/pkg/dbrouter"
 	"casso/pkg/errors"
 	"casso/pkg/util/pagination"
+	"casso/pkg/util/snowflake"
+	"casso/pkg/util/transaction"
 	"context"
+	stderrors "errors"
+	"sort"
+	"strconv"
+	"sync"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-sql-driver/mysql"
 )
 
 var _ biz.UserRepo = (*UserRepo)(nil)
 
 type UserRepo struct {
 	data *Data
+	ids  snowflake.IDGenerator
 	log  *log.Helper
 }
 
-func NewUserRepo(data *Data, logger log.Logger) biz.UserRepo {
+// 写后读标识：用户写入后的窗口期内按ID、手机号读取该用户走主库
+func userKey(id int64) string {
+	return "user:" + strconv.FormatInt(id, 10)
+}
+
+func mobileKey(mobile string) string {
+	return "mobile:" + mobile
+}
+
+func isDuplicate(err error) bool {
+	var e *mysql.MySQLError
+	return stderrors.As(err, &e) && e.Number == 1062
+}
+
+func NewUserRepo(data *Data, ids snowflake.IDGenerator, logger log.Logger) biz.UserRepo {
 	return &UserRepo{
 		data: data,
+		ids:  ids,
 		log:  log.NewHelper(log.With(logger, "module", "data/user")),
 	}
 }
 
 func (r *UserRepo) Create(ctx context.Context, b *model.User) (*model.User, error) {
-	user := &model.User{Name: b.Name, Age: b.Age, Mobile: b.Mobile, Pass: passmd5.Base64Md5(b.Pass)}
-	err := r.data.db.WithContext(ctx).Create(user).First(user).Error
+	id, err := r.ids.NextID()
+	if err != nil {
+		r.log.Errorf("[data.Create] generate id err : %v", err)
+		return &model.User{}, errors.UnknownError
+	}
+	user := &model.User{ID: id, Name: b.Name, Age: b.Age, Mobile: b.Mobile, Pass: passmd5.Base64Md5(b.Pass)}
+	err = r.data.InTx(ctx, func(ctx context.Context) error {
+		// 先写手机号索引，手机号重复时主键冲突，保证跨分表唯一
+		if err := r.data.DB(ctx).Create(&model.UserMobile{Mobile: user.Mobile, UserID: user.ID}).Error; err != nil {
+			return err
+		}
+		return r.data.DB(ctx).Table(r.data.users.Table(user.ID)).Create(user).Error
+	})
+	if isDuplicate(err) {
+		// 手机号索引表主键冲突即手机号已注册
+		return &model.User{}, v1.ErrorUserDuplicateEntry("mobile already registered").WithMetadata(map[string]string{"field": "mobile"})
+	}
 	if err != nil {
 		r.log.Errorf("[data.Create] err : %#v", err)
 		return &model.User{}, errors.UnknownError
 	}
+	r.data.router.Touch(userKey(user.ID))
+	r.data.router.Touch(mobileKey(user.Mobile))
 	return user, nil
 }
 
 func (r *UserRepo) Get(ctx context.Context, id int64) (*model.User, error) {
 	user := model.User{}
-	err := r.data.db.WithContext(ctx).First(&user, id).Error
+	err := r.data.ReadDB(dbrouter.WithKey(ctx, userKey(id))).Table(r.data.users.Table(id)).First(&user, id).Error
 	if err != nil {
 		r.data.log.Errorf("[Get] fail: %v", err)
 		return &model.User{}, errors.RecordNotFound
@@ -55,7 +97,13 @@ func (r *UserRepo) Get(ctx context.Context, id int64) (*model.User, error) {
 
 func (r *UserRepo) Update(ctx context.Context, b *model.User) (*model.User, error) {
 	user := model.User{}
-	if err := r.data.db.Updates(b).First(&user).Error; err != nil {
+	table := r.data.users.Table(b.ID)
+	ctx = dbrouter.WithKey(ctx, userKey(b.ID))
+	if err := r.data.DB(ctx).Table(table).Updates(b).Error; err != nil {
+		r.data.log.Errorf("[Update] fail: %v", err)
+		return &model.User{}, errors.UnknownError
+	}
+	if err := r.data.DB(ctx).Table(table).First(&user, b.ID).Error; err != nil {
 		r.data.log.Errorf("[Update] fail: %v", err)
 		return &model.User{}, errors.UnknownError
 	}
@@ -64,34 +112,94 @@ func (r *UserRepo) Update(ctx context.Context, b *model.User) (*model.User, erro
 
 func (r *UserRepo) Delete(ctx context.Context, id int64) (*model.User, error) {
 	user := model.User{}
-	user.ID = uint(id)
-	err := r.data.db.WithContext(ctx).First(&user).Delete(&user, id).Error
+	table := r.data.users.Table(id)
+	ctx = dbrouter.WithKey(ctx, userKey(id))
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		db := r.data.DB(ctx)
+		if err := db.Table(table).First(&user, id).Error; err != nil {
+			return err
+		}
+		if err := db.Table(table).Delete(&model.User{}, id).Error; err != nil {
+			return err
+		}
+		return db.Delete(&model.UserMobile{}, "mobile = ?", user.Mobile).Error
+	})
 	if err != nil {
 		r.data.log.Errorf("[Delete] fail: %v", err)
 		return &model.User{}, errors.UnknownError
 	}
+	r.data.router.Touch(mobileKey(user.Mobile))
 	return &user, nil
 }
 
+// List 按ID排序分页：各分表并发查询前 offset+pageSize 条，归并后截取当前页
+// 深分页的代价随页码线性增长，后台导出等场景应按ID游标遍历分表
 func (r *UserRepo) List(ctx context.Context, pageNum, pageSize int64) ([]*model.User, error) {
+	offset := int(pagination.GetPageOffset(pageNum, pageSize))
+	if offset < 0 || pageSize <= 0 {
+		return nil, nil
+	}
+	limit := offset + int(pageSize)
+
+	var (
+		tables  = r.data.users.Tables()
+		results = make([][]*model.User, len(tables))
+		errs    = make([]error, len(tables))
+		db      = r.data.ReadDB(ctx)
+		wg      sync.WaitGroup
+	)
+	query := func(i int) {
+		errs[i] = db.Table(tables[i]).Order("id").Limit(limit).Find(&results[i]).Error
+	}
+	if _, ok := transaction.FromContext(ctx); ok {
+		// 事务连接不能并发使用
+		for i := range tables {
+			query(i)
+		}
+	} else {
+		for i := range tables {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				query(i)
+			}(i)
+		}
+		wg.Wait()
+	}
+
 	var userList []*model.User
-	err := r.data.db.WithContext(ctx).
-		Limit(int(pageSize)).
-		Offset(int(pagination.GetPageOffset(pageNum, pageSize))).
-		Find(&userList).Error
-	if err != nil {
-		r.data.log.Error("Get [List] fail: %v", err)
-		return nil, errors.UnknownError
+	for i, err := range errs {
+		if err != nil {
+			r.data.log.Errorf("Get [List] %s fail: %v", tables[i], err)
+			return nil, errors.UnknownError
+		}
+		userList = append(userList, results[i]...)
 	}
+	sort.Slice(userList, func(i, j int) bool {
+		return userList[i].ID < userList[j].ID
+	})
 
-	return userList, nil
+	if offset >= len(userList) {
+		return nil, nil
+	}
+	if limit > len(userList) {
+		limit = len(userList)
+	}
+	return userList[offset:limit], nil
 }
 
 func (r *UserRepo) GetUserByMobile(ctx context.Context, mobile string) (user *model.User, err error) {
-	if err = r.data.db.WithContext(ctx).Where("mobile = ?", mobile).First(&user).Error; err != nil {
+	ctx = dbrouter.WithKey(ctx, mobileKey(mobile))
+	idx := model.UserMobile{}
+	if err = r.data.ReadDB(ctx).Where("mobile = ?", mobile).First(&idx).Error; err != nil {
 		r.data.log.Errorf("[GetUserByMobile] fail: %v", err)
 		return &model.User{}, errors.RecordNotFound
 	}
 
+	user = &model.User{}
+	if err = r.data.ReadDB(ctx).Table(r.data.users.Table(idx.UserID)).First(user, idx.UserID).Error; err != nil {
+		r.data.log.Errorf("[GetUserByMobile] fail: %v", err)
+		return &model.User{}, errors.RecordNotFound
+	}
 	return
 }
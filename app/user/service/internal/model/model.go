@@ -3,14 +3,18 @@ package model
 // user model
 
 var (
+	// UserTableName 分表前缀，实际表名为 user_00 ~ user_{N-1}，由 data 层按 ID 路由
 	UserTableName = "user"
+	// UserMobileTableName 手机号到用户ID的索引表，不分表
+	UserMobileTableName = "user_mobile"
 )
 
+// User ID 由雪花发号器生成，不再使用自增主键
 type User struct {
-	ID     uint   `gorm:"primarykey"`
+	ID     int64  `gorm:"primarykey;autoIncrement:false"`
 	Mobile string `gorm:"unique;COMMENT:手机号"`
 	Pass   string `gorm:"COMMENT:密码"`
-	Name   string `gorm:"COMMENT:用户名"`
+	Name   string `gorm:"COMMENT:用户名" mapper:"NickName"`
 	Age    int64  `gorm:"COMMENT:年龄"`
 
 	UpdatedTime int64 `gorm:"type:bigint(20);COMMENT:最后修改时间"`
@@ -21,3 +25,14 @@ type User struct {
 func (u *User) TableName() string {
 	return UserTableName
 }
+
+// UserMobile 手机号索引，用于 GetUserByMobile 定位用户所在分表，同时保证手机号全局唯一
+type UserMobile struct {
+	Mobile      string `gorm:"primarykey;size:32"`
+	UserID      int64  `gorm:"COMMENT:用户ID"`
+	CreatedTime int64  `gorm:"type:bigint(20);COMMENT:创建时间"`
+}
+
+func (m *UserMobile) TableName() string {
+	return UserMobileTableName
+}
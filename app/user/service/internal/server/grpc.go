@@ -4,6 +4,9 @@ import (
 	v1 "casso/api/user/service/v1"
 	"casso/app/user/service/internal/conf"
 	"casso/app/user/service/internal/service"
+	"casso/pkg/health"
+	"casso/pkg/metrics"
+	"casso/pkg/util/contextkey"
 
 	"github.com/go-kratos/kratos/v2/log"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
@@ -15,14 +18,19 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, logger log.Logger, tp *tracesdk.TracerProvider, s *service.UserService) *grpc.Server {
+func NewGRPCServer(c *conf.Server, logger log.Logger, tp *tracesdk.TracerProvider, s *service.UserService, h *health.Health, mp *metrics.Prometheus) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
 			tracing.Server(
 				tracing.WithTracerProvider(tp)),
 			logging.Server(logger),
+			mp.Server(),
+			// 读取上游传递的登录用户、语言、请求ID等
+			contextkey.Server(),
 		),
+		// 依赖未就绪时健康检查返回 NOT_SERVING
+		grpc.UnaryInterceptor(h.UnaryInterceptor()),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -0,0 +1,33 @@
+package server
+
+import (
+	"casso/app/user/service/internal/conf"
+	"casso/pkg/health"
+	"casso/pkg/metrics"
+
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// NewHTTPServer 管理端口，提供 /metrics 与 /healthz、/readyz 探针，业务接口只走 gRPC
+func NewHTTPServer(c *conf.Server, h *health.Health, mp *metrics.Prometheus) *http.Server {
+	var opts = []http.ServerOption{
+		http.Middleware(
+			recovery.Recovery(),
+		),
+	}
+	if c.Http.Network != "" {
+		opts = append(opts, http.Network(c.Http.Network))
+	}
+	if c.Http.Addr != "" {
+		opts = append(opts, http.Address(c.Http.Addr))
+	}
+	if c.Http.Timeout != nil {
+		opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
+	}
+	srv := http.NewServer(opts...)
+	srv.Handle("/metrics", mp.Handler())
+	srv.HandleFunc("/healthz", h.Liveness)
+	srv.HandleFunc("/readyz", h.Readiness)
+	return srv
+}
@@ -10,47 +10,17 @@ package server
 
 import (
 	"casso/app/user/service/internal/conf"
-	"log"
+	"casso/pkg/registry"
 
 	"github.com/google/wire"
 
 	kr "github.com/go-kratos/kratos/v2/registry"
-	nr "github.com/go-kratos/nacos/registry"
-	"github.com/nacos-group/nacos-sdk-go/clients"
-	"github.com/nacos-group/nacos-sdk-go/common/constant"
-	"github.com/nacos-group/nacos-sdk-go/vo"
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewRegistrar, NewGRPCServer)
+var ProviderSet = wire.NewSet(NewRegistrar, NewGRPCServer, NewHTTPServer)
 
-func NewRegistrar(conf *conf.Registry) kr.Registrar {
-	sc := []constant.ServerConfig{
-		*constant.NewServerConfig(conf.Nacos.Address, uint64(conf.Nacos.Port)),
-	}
-
-	cc := &constant.ClientConfig{
-		NamespaceId:         "public", //namespace id
-		TimeoutMs:           5000,
-		NotLoadCacheAtStart: true,
-		LogDir:              "/tmp/nacos/log",
-		CacheDir:            "/tmp/nacos/cache",
-		RotateTime:          "1h",
-		MaxAge:              3,
-		LogLevel:            "debug",
-	}
-
-	// a more graceful way to create naming client
-	client, err := clients.NewNamingClient(
-		vo.NacosClientParam{
-			ClientConfig:  cc,
-			ServerConfigs: sc,
-		},
-	)
-
-	if err != nil {
-		log.Panic(err)
-	}
-	r := nr.New(client)
-	return r
+// NewRegistrar 按 conf.Registry 的 backend 创建服务注册，默认 nacos
+func NewRegistrar(c *conf.Registry) (kr.Registrar, func(), error) {
+	return registry.NewRegistrar(c)
 }
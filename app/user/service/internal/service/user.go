@@ -5,6 +5,7 @@ import (
 
 	pb "casso/api/user/service/v1"
 	"casso/app/user/service/internal/model"
+	"casso/pkg/util/mapper"
 )
 
 func (s *UserService) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserReply, error) {
@@ -12,8 +13,12 @@ func (s *UserService) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 	if req.NickName == "" || req.Mobile == "" {
 		return &pb.CreateUserReply{}, pb.ErrorUserInvalidParams("invalid params")
 	}
+	var user model.User
+	if err := mapper.Map(&user, req); err != nil {
+		return &pb.CreateUserReply{}, pb.ErrorUserInvalidParams("invalid params")
+	}
 	// 调用业务用例
-	return s.uc.CreateUser(ctx, &model.User{Mobile: req.Mobile, Pass: req.Pass, Name: req.NickName, Age: req.Age})
+	return s.uc.CreateUser(ctx, &user)
 }
 
 func (s *UserService) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserReply, error) {
@@ -0,0 +1,54 @@
+/*
+ * 服务间 gRPC 调用的负载均衡，按注册中心中实例的元数据路由：
+ *
+ *	version 实例版本，灰度请求（x-canary: v2）只路由到该版本
+ *	zone    实例所在可用区，优先调用同可用区的实例
+ *	weight  实例权重，wrr 按权重分配流量
+ *	canary  为 true 时实例只接收灰度请求
+ *
+ * 服务端在 newApp 中通过 kratos.Metadata(balancer.Metadata(Version)) 注册元数据，
+ * 可用区、权重与是否灰度读取环境变量 ZONE、WEIGHT、CANARY
+ */
+package balancer
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/selector/p2c"
+	"github.com/go-kratos/kratos/v2/selector/wrr"
+)
+
+// 实例元数据
+const (
+	MetadataVersion = "version"
+	MetadataZone    = "zone"
+	MetadataWeight  = "weight"
+	MetadataCanary  = "canary"
+)
+
+// 负载均衡算法，kratos 已注册为 gRPC balancer，客户端通过 grpc.WithBalancerName 选择
+const (
+	P2C = p2c.Name // 默认，两次随机选择中按 EWMA 延迟与在途请求数取较优的实例
+	WRR = wrr.Name // 平滑加权轮询，按 weight 分配流量
+)
+
+// Metadata 注册到注册中心的实例元数据
+func Metadata(version string) map[string]string {
+	md := map[string]string{MetadataVersion: version}
+	if zone := LocalZone(); zone != "" {
+		md[MetadataZone] = zone
+	}
+	if w, err := strconv.Atoi(os.Getenv("WEIGHT")); err == nil && w > 0 {
+		md[MetadataWeight] = strconv.Itoa(w)
+	}
+	if canary, _ := strconv.ParseBool(os.Getenv("CANARY")); canary {
+		md[MetadataCanary] = "true"
+	}
+	return md
+}
+
+// LocalZone 当前实例所在的可用区
+func LocalZone() string {
+	return os.Getenv("ZONE")
+}
@@ -0,0 +1,67 @@
+package balancer
+
+import (
+	"casso/pkg/util/contextkey"
+	"context"
+
+	"github.com/go-kratos/kratos/v2/selector"
+)
+
+// Filters 客户端默认的节点过滤：先按灰度版本，再优先同可用区
+func Filters(zone string) []selector.Filter {
+	return []selector.Filter{Canary(), Zone(zone)}
+}
+
+// Canary 请求携带灰度版本（contextkey.Canary，对外入口取自 X-Canary 请求头）时只返回该版本的实例，
+// 没有该版本时返回空列表，请求失败而不是落到其他版本；
+// 未携带时排除标记为 canary 的实例，全部为灰度实例时不过滤
+func Canary() selector.Filter {
+	return func(ctx context.Context, nodes []selector.Node) []selector.Node {
+		if version := contextkey.Canary.Value(ctx); version != "" {
+			res := make([]selector.Node, 0, len(nodes))
+			for _, n := range nodes {
+				if nodeVersion(n) == version {
+					res = append(res, n)
+				}
+			}
+			return res
+		}
+		res := make([]selector.Node, 0, len(nodes))
+		for _, n := range nodes {
+			if n.Metadata()[MetadataCanary] != "true" {
+				res = append(res, n)
+			}
+		}
+		if len(res) == 0 {
+			return nodes
+		}
+		return res
+	}
+}
+
+// Zone 优先返回可用区为 zone 的实例，同可用区没有实例或 zone 为空时不过滤
+func Zone(zone string) selector.Filter {
+	return func(ctx context.Context, nodes []selector.Node) []selector.Node {
+		if zone == "" {
+			return nodes
+		}
+		res := make([]selector.Node, 0, len(nodes))
+		for _, n := range nodes {
+			if n.Metadata()[MetadataZone] == zone {
+				res = append(res, n)
+			}
+		}
+		if len(res) == 0 {
+			return nodes
+		}
+		return res
+	}
+}
+
+// nodeVersion 优先使用元数据中的版本，兼容只设置了 kratos.Version 的实例
+func nodeVersion(n selector.Node) string {
+	if v := n.Metadata()[MetadataVersion]; v != "" {
+		return v
+	}
+	return n.Version()
+}
@@ -0,0 +1,73 @@
+package balancer
+
+import (
+	"casso/pkg/util/contextkey"
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/selector"
+)
+
+type testNode struct {
+	addr string
+	md   map[string]string
+}
+
+func (n *testNode) Scheme() string              { return "grpc" }
+func (n *testNode) Address() string             { return n.addr }
+func (n *testNode) ServiceName() string         { return "casso.user.service.grpc" }
+func (n *testNode) InitialWeight() *int64       { return nil }
+func (n *testNode) Version() string             { return "v1" }
+func (n *testNode) Metadata() map[string]string { return n.md }
+
+func addrs(nodes []selector.Node) []string {
+	res := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		res = append(res, n.Address())
+	}
+	return res
+}
+
+func TestFilters(t *testing.T) {
+	nodes := []selector.Node{
+		&testNode{addr: "a", md: map[string]string{"zone": "sh-1"}},
+		&testNode{addr: "b", md: map[string]string{"zone": "sh-2"}},
+		&testNode{addr: "c", md: map[string]string{"version": "v2", "zone": "sh-2", "canary": "true"}},
+	}
+	apply := func(ctx context.Context, zone string) []string {
+		res := nodes
+		for _, f := range Filters(zone) {
+			res = f(ctx, res)
+		}
+		return addrs(res)
+	}
+
+	tests := []struct {
+		name   string
+		canary string
+		zone   string
+		want   []string
+	}{
+		{name: "stable", want: []string{"a", "b"}},
+		{name: "same zone", zone: "sh-1", want: []string{"a"}},
+		{name: "no instance in zone", zone: "bj-1", want: []string{"a", "b"}},
+		{name: "canary", canary: "v2", zone: "sh-1", want: []string{"c"}},
+		{name: "canary by kratos version", canary: "v1", want: []string{"a", "b"}},
+		{name: "unknown canary", canary: "v3", want: []string{}},
+	}
+	for _, tt := range tests {
+		ctx := context.Background()
+		if tt.canary != "" {
+			ctx = contextkey.Canary.With(ctx, tt.canary)
+		}
+		got := apply(ctx, tt.zone)
+		if len(got) != len(tt.want) {
+			t.Fatalf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Fatalf("%s: got %v, want %v", tt.name, got, tt.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,327 @@
+/*
+ * 配置：在 kratos config 之上提供类型化的加载与订阅
+ *
+ * Load 读取配置并校验，Watch 在配置变更时重新读取、校验，通过后替换并回调 fn(old, new)；
+ * 校验失败或读取失败的变更不生效，继续使用上一次合法的配置。实现了 Validate() error 的类型
+ * （protoc-gen-validate 生成或手写）在加载时校验
+ *
+ * 环境变量覆盖配置文件：CASSO_ 前缀，层级之间用两个下划线分隔，如 CASSO_DATA__REDIS__ADDR 覆盖 data.redis.addr
+ */
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	kconfig "github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v2"
+)
+
+// DefaultEnvPrefix 环境变量覆盖配置的默认前缀
+const DefaultEnvPrefix = "CASSO_"
+
+// Resolver 在每次加载、重新加载后修改合并后的配置，按添加顺序执行，环境变量覆盖最先执行
+type Resolver = kconfig.Resolver
+
+type options struct {
+	sources   []kconfig.Source
+	envPrefix string
+	resolvers []Resolver
+	logger    log.Logger
+}
+
+// Option 配置选项
+type Option func(*options)
+
+// WithSource 配置源，后面的源覆盖前面的源中相同的 key
+func WithSource(s ...kconfig.Source) Option {
+	return func(o *options) { o.sources = append(o.sources, s...) }
+}
+
+// WithEnvPrefix 环境变量覆盖的前缀，为空时不读取环境变量
+func WithEnvPrefix(prefix string) Option {
+	return func(o *options) { o.envPrefix = prefix }
+}
+
+// WithResolver 添加 Resolver
+func WithResolver(r ...Resolver) Option {
+	return func(o *options) { o.resolvers = append(o.resolvers, r...) }
+}
+
+// WithLogger 输出重新加载失败等日志
+func WithLogger(logger log.Logger) Option {
+	return func(o *options) { o.logger = logger }
+}
+
+// Config kratos config.Config，额外记录日志
+type Config struct {
+	kconfig.Config
+	log *log.Helper
+}
+
+// New 创建并加载配置，yaml 使用 yaml.v2 解析，其他格式使用 kratos encoding 中注册的 codec
+func New(opts ...Option) (*Config, error) {
+	o := options{envPrefix: DefaultEnvPrefix, logger: log.DefaultLogger}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	resolvers := o.resolvers
+	if o.envPrefix != "" {
+		resolvers = append([]Resolver{EnvResolver(o.envPrefix)}, resolvers...)
+	}
+
+	c := kconfig.New(
+		kconfig.WithSource(o.sources...),
+		kconfig.WithDecoder(decode),
+		kconfig.WithResolver(func(m map[string]interface{}) error {
+			for _, r := range resolvers {
+				if err := r(m); err != nil {
+					return err
+				}
+			}
+			return nil
+		}),
+		kconfig.WithLogger(o.logger),
+	)
+	if err := c.Load(); err != nil {
+		return nil, err
+	}
+	return &Config{Config: c, log: log.NewHelper(log.With(o.logger, "module", "pkg/config"))}, nil
+}
+
+// SetLogger 创建服务的 logger 之后替换，在 Watch 之前调用
+func (c *Config) SetLogger(logger log.Logger) {
+	c.log = log.NewHelper(log.With(logger, "module", "pkg/config"))
+}
+
+func decode(kv *kconfig.KeyValue, v map[string]interface{}) error {
+	switch kv.Format {
+	case "yaml", "yml":
+		return yaml.Unmarshal(kv.Value, v)
+	}
+	if codec := encoding.GetCodec(kv.Format); codec != nil {
+		return codec.Unmarshal(kv.Value, &v)
+	}
+	return fmt.Errorf("config: unsupported format %q of %s", kv.Format, kv.Key)
+}
+
+// Load 读取 key（为空时读取全部配置）到 T 并校验，T 为结构体、proto message 或切片等 json 可以解析的类型
+func Load[T any](c *Config, key string) (*T, error) {
+	v := new(T)
+	var err error
+	if key == "" {
+		err = c.Scan(v)
+	} else {
+		err = c.Value(key).Scan(v)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("config: scan %q: %w", key, err)
+	}
+	if err := validate(v); err != nil {
+		return nil, fmt.Errorf("config: validate %q: %w", key, err)
+	}
+	return v, nil
+}
+
+// Value 持有 key 最新的合法配置，并发安全
+type Value[T any] struct {
+	key string
+	mu  sync.Mutex
+	v   atomic.Value
+	fn  func(old, new *T)
+}
+
+// Load 最新的合法配置，返回值只读
+func (v *Value[T]) Load() *T {
+	return v.v.Load().(*T)
+}
+
+// update 读取并校验新配置，与当前配置不同时替换并回调，返回变化的字段
+func (v *Value[T]) update(scan func(interface{}) error) ([]string, error) {
+	nv := new(T)
+	if err := scan(nv); err != nil {
+		return nil, err
+	}
+	if err := validate(nv); err != nil {
+		return nil, err
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	old := v.Load()
+	changed := Diff(old, nv)
+	if len(changed) == 0 {
+		return nil, nil
+	}
+	v.v.Store(nv)
+	if v.fn != nil {
+		v.fn(old, nv)
+	}
+	return changed, nil
+}
+
+// Watch 读取 key 并订阅变更：新配置通过校验且与当前配置不同时替换，并回调 fn(old, new)；
+// 非法的变更只记录日志，Value 继续返回上一次合法的配置。key 不能为空
+func Watch[T any](c *Config, key string, fn func(old, new *T)) (*Value[T], error) {
+	cur, err := Load[T](c, key)
+	if err != nil {
+		return nil, err
+	}
+	v := &Value[T]{key: key, fn: fn}
+	v.v.Store(cur)
+	err = c.Watch(key, func(_ string, kv kconfig.Value) {
+		changed, err := v.update(kv.Scan)
+		if err != nil {
+			c.log.Errorw("msg", "config reload rejected, keep last good value", "key", key, "error", err.Error())
+			return
+		}
+		if len(changed) > 0 {
+			c.log.Infow("msg", "config reloaded", "key", key, "changed", strings.Join(changed, ","))
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("config: watch %q: %w", key, err)
+	}
+	return v, nil
+}
+
+func validate(v interface{}) error {
+	if vv, ok := v.(interface{ Validate() error }); ok {
+		return vv.Validate()
+	}
+	return nil
+}
+
+// Diff 比较两份配置，返回值不同的字段路径（json 名，如 redis.addr），按字典序排列
+func Diff(old, new interface{}) []string {
+	a, b := toMap(old), toMap(new)
+	var changed []string
+	diff("", a, b, &changed)
+	sort.Strings(changed)
+	return changed
+}
+
+func toMap(v interface{}) interface{} {
+	var (
+		data []byte
+		err  error
+	)
+	if m, ok := v.(proto.Message); ok {
+		data, err = protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	} else {
+		data, err = json.Marshal(v)
+	}
+	if err != nil {
+		return nil
+	}
+	var out interface{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil
+	}
+	return out
+}
+
+func diff(path string, a, b interface{}, changed *[]string) {
+	am, aok := a.(map[string]interface{})
+	bm, bok := b.(map[string]interface{})
+	if !aok || !bok {
+		if !reflect.DeepEqual(a, b) {
+			if path == "" {
+				path = "."
+			}
+			*changed = append(*changed, path)
+		}
+		return
+	}
+	keys := make(map[string]struct{}, len(am)+len(bm))
+	for k := range am {
+		keys[k] = struct{}{}
+	}
+	for k := range bm {
+		keys[k] = struct{}{}
+	}
+	for k := range keys {
+		p := k
+		if path != "" {
+			p = path + "." + k
+		}
+		diff(p, am[k], bm[k], changed)
+	}
+}
+
+// EnvResolver 用 prefix 开头的环境变量覆盖配置，层级之间用两个下划线分隔，切片使用下标，
+// 如 CASSO_DATA__DATABASE__REPLICAS__0__SOURCE；原值为布尔或数字时按原类型转换
+func EnvResolver(prefix string) Resolver {
+	return func(m map[string]interface{}) error {
+		for _, env := range os.Environ() {
+			i := strings.IndexByte(env, '=')
+			if i < 0 || !strings.HasPrefix(env[:i], prefix) || i == len(prefix) {
+				continue
+			}
+			path := strings.Split(strings.ToLower(env[len(prefix):i]), "__")
+			setPath(m, path, env[i+1:])
+		}
+		return nil
+	}
+}
+
+func setPath(m map[string]interface{}, path []string, value string) {
+	var cur interface{} = m
+	for i, p := range path {
+		last := i == len(path)-1
+		switch node := cur.(type) {
+		case map[string]interface{}:
+			if last {
+				node[p] = convert(node[p], value)
+				return
+			}
+			next, ok := node[p]
+			if !ok {
+				next = map[string]interface{}{}
+				node[p] = next
+			}
+			cur = next
+		case []interface{}:
+			idx, err := strconv.Atoi(p)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return
+			}
+			if last {
+				node[idx] = convert(node[idx], value)
+				return
+			}
+			cur = node[idx]
+		default:
+			return
+		}
+	}
+}
+
+func convert(old interface{}, value string) interface{} {
+	switch old.(type) {
+	case bool:
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	case int, int32, int64, uint, uint32, uint64:
+		if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return n
+		}
+	case float32, float64:
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	}
+	return value
+}
@@ -0,0 +1,100 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"casso/pkg/logging"
+)
+
+func TestEnvResolver(t *testing.T) {
+	t.Setenv("CASSO_DATA__REDIS__ADDR", "10.0.0.1:6379")
+	t.Setenv("CASSO_DATA__REDIS__POOL", "20")
+	t.Setenv("CASSO_DATA__REPLICAS__1__SOURCE", "replica-1")
+	t.Setenv("CASSO_LOG__LEVEL", "debug")
+	t.Setenv("CASSO_TRACE__INSECURE", "false")
+	t.Setenv("OTHER_LOG__LEVEL", "error")
+
+	m := map[string]interface{}{
+		"data": map[string]interface{}{
+			"redis":    map[string]interface{}{"addr": "127.0.0.1:6379", "pool": 10},
+			"replicas": []interface{}{map[string]interface{}{"source": "a"}, map[string]interface{}{"source": "b"}},
+		},
+		"trace": map[string]interface{}{"insecure": true},
+	}
+	if err := EnvResolver(DefaultEnvPrefix)(m); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"data": map[string]interface{}{
+			"redis":    map[string]interface{}{"addr": "10.0.0.1:6379", "pool": int64(20)},
+			"replicas": []interface{}{map[string]interface{}{"source": "a"}, map[string]interface{}{"source": "replica-1"}},
+		},
+		"trace": map[string]interface{}{"insecure": false},
+		"log":   map[string]interface{}{"level": "debug"},
+	}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("got %v", m)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	old := &logging.Config{Level: "info", Format: "json"}
+	new := &logging.Config{Level: "debug", Format: "json", Sampling: &logging.Config_Sampling{Initial: 10}}
+	got := Diff(old, new)
+	if want := []string{"level", "sampling"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := Diff(old, &logging.Config{Level: "info", Format: "json"}); len(got) != 0 {
+		t.Errorf("got %v", got)
+	}
+}
+
+type limits struct {
+	Max int `json:"max"`
+}
+
+func (l *limits) Validate() error {
+	if l.Max <= 0 {
+		return errors.New("max must be positive")
+	}
+	return nil
+}
+
+func scanJSON(s string) func(interface{}) error {
+	return func(v interface{}) error { return json.Unmarshal([]byte(s), v) }
+}
+
+func TestValueUpdate(t *testing.T) {
+	var calls [][2]int
+	v := &Value[limits]{key: "limits", fn: func(old, new *limits) {
+		calls = append(calls, [2]int{old.Max, new.Max})
+	}}
+	v.v.Store(&limits{Max: 1})
+
+	if _, err := v.update(scanJSON(`{"max": 0}`)); err == nil {
+		t.Error("want validate error")
+	}
+	if _, err := v.update(scanJSON(`{"max": "x"}`)); err == nil {
+		t.Error("want scan error")
+	}
+	if v.Load().Max != 1 {
+		t.Errorf("bad reload should keep last good value, got %d", v.Load().Max)
+	}
+
+	changed, err := v.update(scanJSON(`{"max": 5}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(changed, []string{"max"}) || v.Load().Max != 5 {
+		t.Errorf("changed %v, value %d", changed, v.Load().Max)
+	}
+	if changed, _ := v.update(scanJSON(`{"max": 5}`)); changed != nil {
+		t.Errorf("unchanged value should not notify, got %v", changed)
+	}
+	if !reflect.DeepEqual(calls, [][2]int{{1, 5}}) {
+		t.Errorf("calls %v", calls)
+	}
+}
@@ -0,0 +1,178 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"casso/pkg/registry"
+
+	"github.com/go-kratos/kratos/contrib/config/apollo/v2"
+	kconfig "github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+	nc "github.com/go-kratos/nacos/config"
+	"github.com/nacos-group/nacos-sdk-go/clients"
+)
+
+// 远程配置源
+const (
+	BackendFile   = "file" // 默认，只使用本地文件
+	BackendApollo = "apollo"
+	BackendNacos  = "nacos"
+)
+
+// RemoteKey 本地配置文件中远程配置源所在的 key
+const RemoteKey = "config"
+
+// Validate 校验远程配置源
+func (x *Remote) Validate() error {
+	switch x.GetBackend() {
+	case "", BackendFile:
+	case BackendApollo:
+		a := x.GetApollo()
+		if a.GetAddr() == "" || a.GetAppId() == "" {
+			return errors.New("config: apollo addr and app_id are required")
+		}
+		if len(a.GetNamespace()) == 0 {
+			return errors.New("config: apollo namespace is required")
+		}
+	case BackendNacos:
+		if len(x.GetNacos().GetDataId()) == 0 {
+			return errors.New("config: nacos data_id is required")
+		}
+	default:
+		return fmt.Errorf("config: unknown backend %q", x.GetBackend())
+	}
+	return nil
+}
+
+// Open 加载本地配置文件 path，并按其中的 config 选择远程配置源叠加在本地文件之上：
+// 本地文件 < 远程配置（按列出的顺序）< 环境变量。远程配置成功加载后写入本地备份，
+// 远程不可用时使用备份启动，没有备份时返回错误。配置了 secret 时，
+// 本地与远程配置中的 ${secret:name} 通过对应的 SecretProvider 替换
+func Open(path string, opts ...Option) (*Config, error) {
+	local, err := New(append([]Option{WithSource(file.NewSource(path))}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	s, err := loadOptional[Secret](local, SecretKey)
+	if err != nil {
+		_ = local.Close()
+		return nil, err
+	}
+	if s != nil {
+		// 远程配置源的配置也可以引用密钥，添加 SecretResolver 后重新加载本地文件
+		_ = local.Close()
+		p, err := NewSecretProvider(s)
+		if err != nil {
+			return nil, err
+		}
+		opts = append([]Option{WithResolver(SecretResolver(p))}, opts...)
+		if local, err = New(append([]Option{WithSource(file.NewSource(path))}, opts...)...); err != nil {
+			return nil, err
+		}
+	}
+	r, err := loadOptional[Remote](local, RemoteKey)
+	if err != nil {
+		_ = local.Close()
+		return nil, err
+	}
+	if r.GetBackend() == "" || r.GetBackend() == BackendFile {
+		return local, nil
+	}
+	_ = local.Close()
+
+	o := options{logger: log.DefaultLogger}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	remotes, err := remoteSources(r, o.logger)
+	if err != nil {
+		return nil, err
+	}
+	sources := append([]kconfig.Source{file.NewSource(path)}, remotes...)
+	return New(append([]Option{WithSource(newLayered(sources...))}, opts...)...)
+}
+
+// loadOptional 读取 key，未配置时返回 nil
+func loadOptional[T any](c *Config, key string) (*T, error) {
+	if c.Value(key).Load() == nil {
+		return nil, nil
+	}
+	return Load[T](c, key)
+}
+
+// remoteSources 远程配置源，每个 namespace / data_id 一个，带本地备份
+func remoteSources(r *Remote, logger log.Logger) ([]kconfig.Source, error) {
+	dir := r.GetBackupDir()
+	if dir == "" {
+		dir = filepath.Join(os.TempDir(), "casso", "config")
+	}
+	var sources []kconfig.Source
+	switch r.GetBackend() {
+	case BackendApollo:
+		a := r.GetApollo()
+		cluster := a.GetCluster()
+		if cluster == "" {
+			cluster = "default"
+		}
+		for _, ns := range a.GetNamespace() {
+			src, err := newApollo(a, cluster, ns)
+			if err != nil {
+				// apollo 不可用时 agollo 启动失败，只使用本地备份
+				log.NewHelper(log.With(logger, "module", "pkg/config")).Warnw("msg", "create apollo source failed", "namespace", ns, "error", err.Error())
+			}
+			sources = append(sources, newBackup(src, backupPath(dir, BackendApollo, a.GetAppId(), cluster, ns), logger))
+		}
+	case BackendNacos:
+		n := r.GetNacos()
+		param, err := registry.NacosClientParam(n.GetClient())
+		if err != nil {
+			return nil, err
+		}
+		client, err := clients.NewConfigClient(param)
+		if err != nil {
+			return nil, fmt.Errorf("config: create nacos config client: %w", err)
+		}
+		group := n.GetClient().GetGroup()
+		if group == "" {
+			group = "DEFAULT_GROUP"
+		}
+		for _, id := range n.GetDataId() {
+			src := nc.NewConfigSource(client, nc.WithGroup(group), nc.WithDataID(id))
+			sources = append(sources, newBackup(src, backupPath(dir, BackendNacos, n.GetClient().GetNamespaceId(), group, id), logger))
+		}
+	}
+	return sources, nil
+}
+
+// newApollo 每个 namespace 单独一个配置源，按列出的顺序叠加
+func newApollo(a *Remote_Apollo, cluster, ns string) (src kconfig.Source, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			src, err = nil, fmt.Errorf("config: create apollo source: %v", r)
+		}
+	}()
+	return apollo.NewSource(
+		apollo.WithAppID(a.GetAppId()),
+		apollo.WithCluster(cluster),
+		apollo.WithEndpoint(a.GetAddr()),
+		apollo.WithNamespace(ns),
+		apollo.WithSecret(a.GetSecret()),
+	), nil
+}
+
+var unsafePath = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+func backupPath(dir, backend string, parts ...string) string {
+	name := backend
+	for _, p := range parts {
+		if p != "" {
+			name += "-" + unsafePath.ReplaceAllString(p, "_")
+		}
+	}
+	return filepath.Join(dir, name+".json")
+}
@@ -0,0 +1,313 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 密钥来源
+const (
+	SecretEnv   = "env" // 默认
+	SecretFile  = "file"
+	SecretVault = "vault"
+)
+
+// SecretKey 本地配置文件中密钥来源所在的 key
+const SecretKey = "secret"
+
+// DefaultSecretRefresh 密钥的默认缓存时间
+const DefaultSecretRefresh = 5 * time.Minute
+
+// ErrSecretNotFound 密钥不存在
+var ErrSecretNotFound = errors.New("config: secret not found")
+
+// SecretProvider 按名称读取密钥，配置中通过 ${secret:name} 引用
+type SecretProvider interface {
+	Secret(ctx context.Context, name string) (string, error)
+}
+
+// Validate 校验密钥来源
+func (x *Secret) Validate() error {
+	switch x.GetProvider() {
+	case "", SecretEnv, SecretFile:
+	case SecretVault:
+		if x.GetVault().GetAddr() == "" {
+			return errors.New("config: vault addr is required")
+		}
+		switch x.GetVault().GetKvVersion() {
+		case 0, 1, 2:
+		default:
+			return fmt.Errorf("config: unknown vault kv_version %d", x.GetVault().GetKvVersion())
+		}
+	default:
+		return fmt.Errorf("config: unknown secret provider %q", x.GetProvider())
+	}
+	return nil
+}
+
+// NewSecretProvider 按配置创建带缓存的 SecretProvider
+func NewSecretProvider(c *Secret) (SecretProvider, error) {
+	var p SecretProvider
+	switch c.GetProvider() {
+	case "", SecretEnv:
+		prefix := c.GetEnvPrefix()
+		if prefix == "" {
+			prefix = "SECRET_"
+		}
+		p = EnvSecrets(prefix)
+	case SecretFile:
+		dir := c.GetDir()
+		if dir == "" {
+			dir = "/etc/casso/secrets"
+		}
+		p = FileSecrets(dir)
+	case SecretVault:
+		v, err := VaultSecrets(c.GetVault())
+		if err != nil {
+			return nil, err
+		}
+		p = v
+	default:
+		return nil, fmt.Errorf("config: unknown secret provider %q", c.GetProvider())
+	}
+	refresh := DefaultSecretRefresh
+	if c.GetRefresh() != nil {
+		refresh = c.GetRefresh().AsDuration()
+	}
+	return CachedSecrets(p, refresh), nil
+}
+
+type envSecrets string
+
+var unsafeEnv = regexp.MustCompile(`[^A-Z0-9]+`)
+
+// EnvSecrets 从环境变量读取密钥，name 转为大写、非字母数字转为下划线后加上 prefix，如 mysql-dsn 读取 SECRET_MYSQL_DSN
+func EnvSecrets(prefix string) SecretProvider {
+	return envSecrets(prefix)
+}
+
+func (p envSecrets) Secret(_ context.Context, name string) (string, error) {
+	key := string(p) + unsafeEnv.ReplaceAllString(strings.ToUpper(name), "_")
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return "", fmt.Errorf("%w: env %s", ErrSecretNotFound, key)
+	}
+	return v, nil
+}
+
+type fileSecrets string
+
+// FileSecrets 从 dir 下与 name 同名的文件读取密钥，去掉末尾的换行，适用于 Kubernetes 挂载的 Secret
+func FileSecrets(dir string) SecretProvider {
+	return fileSecrets(dir)
+}
+
+func (p fileSecrets) Secret(_ context.Context, name string) (string, error) {
+	if name == "" || name != filepath.Base(name) {
+		return "", fmt.Errorf("config: invalid secret name %q", name)
+	}
+	data, err := os.ReadFile(filepath.Join(string(p), name))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("%w: file %s", ErrSecretNotFound, name)
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+type vaultSecrets struct {
+	c      *Secret_Vault
+	token  func() (string, error)
+	client *http.Client
+}
+
+// VaultSecrets 通过 HTTP API 从 Vault（或兼容的服务）的 KV 引擎读取密钥，name 为 path#key，省略 key 时读取 value
+func VaultSecrets(c *Secret_Vault) (SecretProvider, error) {
+	if c.GetAddr() == "" {
+		return nil, errors.New("config: vault addr is required")
+	}
+	timeout := 5 * time.Second
+	if c.GetTimeout() != nil {
+		timeout = c.GetTimeout().AsDuration()
+	}
+	token := func() (string, error) { return os.Getenv("VAULT_TOKEN"), nil }
+	if path := c.GetTokenFile(); path != "" {
+		// 每次读取文件，token 轮换后不需要重启
+		token = func() (string, error) {
+			data, err := os.ReadFile(path)
+			return strings.TrimSpace(string(data)), err
+		}
+	}
+	return &vaultSecrets{c: c, token: token, client: &http.Client{Timeout: timeout}}, nil
+}
+
+func (p *vaultSecrets) Secret(ctx context.Context, name string) (string, error) {
+	path, key := name, "value"
+	if i := strings.LastIndexByte(name, '#'); i >= 0 {
+		path, key = name[:i], name[i+1:]
+	}
+	mount := p.c.GetMount()
+	if mount == "" {
+		mount = "secret"
+	}
+	url := strings.TrimRight(p.c.GetAddr(), "/") + "/v1/" + strings.Trim(mount, "/")
+	if p.c.GetKvVersion() != 1 {
+		url += "/data"
+	}
+	url += "/" + strings.TrimLeft(path, "/")
+
+	token, err := p.token()
+	if err != nil {
+		return "", fmt.Errorf("config: read vault token: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if ns := p.c.GetNamespace(); ns != "" {
+		req.Header.Set("X-Vault-Namespace", ns)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("config: read vault secret %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return "", fmt.Errorf("%w: vault %s", ErrSecretNotFound, path)
+	case resp.StatusCode != http.StatusOK:
+		return "", fmt.Errorf("config: read vault secret %s: %s", path, resp.Status)
+	}
+
+	// KV v1: {"data": {...}}，KV v2: {"data": {"data": {...}, "metadata": {...}}}
+	var body struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("config: decode vault secret %s: %w", path, err)
+	}
+	data := body.Data
+	if p.c.GetKvVersion() != 1 {
+		var v2 struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal(data, &v2); err != nil {
+			return "", fmt.Errorf("config: decode vault secret %s: %w", path, err)
+		}
+		data = v2.Data
+	}
+	var kv map[string]interface{}
+	if err := json.Unmarshal(data, &kv); err != nil {
+		return "", fmt.Errorf("config: decode vault secret %s: %w", path, err)
+	}
+	v, ok := kv[key]
+	if !ok {
+		return "", fmt.Errorf("%w: vault %s#%s", ErrSecretNotFound, path, key)
+	}
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+	return fmt.Sprint(v), nil
+}
+
+type cachedSecret struct {
+	value   string
+	fetched time.Time
+}
+
+type cachedSecrets struct {
+	p       SecretProvider
+	refresh time.Duration
+	now     func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedSecret
+}
+
+// CachedSecrets 缓存 p 读取的密钥，超过 refresh 后重新读取；重新读取失败时继续使用缓存，
+// 避免密钥服务短暂不可用导致配置重新加载失败
+func CachedSecrets(p SecretProvider, refresh time.Duration) SecretProvider {
+	return &cachedSecrets{p: p, refresh: refresh, now: time.Now, cache: map[string]cachedSecret{}}
+}
+
+func (c *cachedSecrets) Secret(ctx context.Context, name string) (string, error) {
+	c.mu.Lock()
+	old, ok := c.cache[name]
+	c.mu.Unlock()
+	if ok && c.now().Sub(old.fetched) < c.refresh {
+		return old.value, nil
+	}
+
+	v, err := c.p.Secret(ctx, name)
+	if err != nil {
+		if ok {
+			return old.value, nil
+		}
+		return "", err
+	}
+	c.mu.Lock()
+	c.cache[name] = cachedSecret{value: v, fetched: c.now()}
+	c.mu.Unlock()
+	return v, nil
+}
+
+var secretRef = regexp.MustCompile(`\$\{secret:([^}]+)\}`)
+
+// SecretResolver 把配置中字符串里的 ${secret:name} 替换为 p 读取的密钥，可以只替换一部分，
+// 如 root:${secret:mysql-password}@tcp(127.0.0.1:3306)/goweb；任一密钥读取失败时返回错误
+func SecretResolver(p SecretProvider) Resolver {
+	return func(m map[string]interface{}) error {
+		_, err := resolveSecrets(p, "", m)
+		return err
+	}
+}
+
+func resolveSecrets(p SecretProvider, path string, v interface{}) (interface{}, error) {
+	switch node := v.(type) {
+	case map[string]interface{}:
+		for k, child := range node {
+			kp := k
+			if path != "" {
+				kp = path + "." + k
+			}
+			nv, err := resolveSecrets(p, kp, child)
+			if err != nil {
+				return nil, err
+			}
+			node[k] = nv
+		}
+		return node, nil
+	case []interface{}:
+		for i := range node {
+			nv, err := resolveSecrets(p, fmt.Sprintf("%s.%d", path, i), node[i])
+			if err != nil {
+				return nil, err
+			}
+			node[i] = nv
+		}
+		return node, nil
+	case string:
+		var rerr error
+		s := secretRef.ReplaceAllStringFunc(node, func(ref string) string {
+			name := secretRef.FindStringSubmatch(ref)[1]
+			secret, err := p.Secret(context.Background(), name)
+			if err != nil && rerr == nil {
+				rerr = fmt.Errorf("config: resolve %s: %w", path, err)
+			}
+			return secret
+		})
+		return s, rerr
+	}
+	return v, nil
+}
@@ -0,0 +1,135 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSecretProviders(t *testing.T) {
+	ctx := context.Background()
+	t.Setenv("SECRET_MYSQL_DSN", "root:pw@tcp(db:3306)/goweb")
+	if v, err := EnvSecrets("SECRET_").Secret(ctx, "mysql-dsn"); err != nil || v != "root:pw@tcp(db:3306)/goweb" {
+		t.Errorf("env: %q, %v", v, err)
+	}
+	if _, err := EnvSecrets("SECRET_").Secret(ctx, "missing"); !errors.Is(err, ErrSecretNotFound) {
+		t.Errorf("env: %v", err)
+	}
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "redis-password"), []byte("s3cret\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := FileSecrets(dir).Secret(ctx, "redis-password"); err != nil || v != "s3cret" {
+		t.Errorf("file: %q, %v", v, err)
+	}
+	if _, err := FileSecrets(dir).Secret(ctx, "../redis-password"); err == nil {
+		t.Error("file: want error for path outside dir")
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "root" || r.URL.Path != "/v1/secret/data/casso/user" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"data": {"jwt_key": "k1", "value": "v"}, "metadata": {"version": 3}}}`))
+	}))
+	defer srv.Close()
+	t.Setenv("VAULT_TOKEN", "root")
+	vault, err := VaultSecrets(&Secret_Vault{Addr: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := vault.Secret(ctx, "casso/user#jwt_key"); err != nil || v != "k1" {
+		t.Errorf("vault: %q, %v", v, err)
+	}
+	if v, err := vault.Secret(ctx, "casso/user"); err != nil || v != "v" {
+		t.Errorf("vault: %q, %v", v, err)
+	}
+	if _, err := vault.Secret(ctx, "casso/box#jwt_key"); !errors.Is(err, ErrSecretNotFound) {
+		t.Errorf("vault: %v", err)
+	}
+}
+
+type countingSecrets struct {
+	calls int
+	value string
+	err   error
+}
+
+func (p *countingSecrets) Secret(context.Context, string) (string, error) {
+	p.calls++
+	return p.value, p.err
+}
+
+func TestCachedSecrets(t *testing.T) {
+	ctx := context.Background()
+	p := &countingSecrets{value: "v1"}
+	now := time.Unix(0, 0)
+	c := CachedSecrets(p, time.Minute).(*cachedSecrets)
+	c.now = func() time.Time { return now }
+
+	_, _ = c.Secret(ctx, "a")
+	_, _ = c.Secret(ctx, "a")
+	if p.calls != 1 {
+		t.Errorf("calls = %d, want 1", p.calls)
+	}
+
+	now = now.Add(2 * time.Minute)
+	p.value = "v2"
+	if v, _ := c.Secret(ctx, "a"); v != "v2" || p.calls != 2 {
+		t.Errorf("after refresh: %q, calls %d", v, p.calls)
+	}
+
+	// 刷新失败时继续使用缓存，没有缓存时返回错误
+	now = now.Add(2 * time.Minute)
+	p.err = errors.New("unavailable")
+	if v, err := c.Secret(ctx, "a"); err != nil || v != "v2" {
+		t.Errorf("stale: %q, %v", v, err)
+	}
+	if _, err := c.Secret(ctx, "b"); err == nil {
+		t.Error("want error without cache")
+	}
+}
+
+func TestSecretResolver(t *testing.T) {
+	t.Setenv("SECRET_MYSQL_PASSWORD", "pw")
+	t.Setenv("SECRET_REDIS_PASSWORD", "rpw")
+	m := map[string]interface{}{
+		"data": map[string]interface{}{
+			"database": map[string]interface{}{
+				"source":   "root:${secret:mysql-password}@tcp(127.0.0.1:3306)/goweb",
+				"replicas": []interface{}{map[string]interface{}{"source": "${secret:mysql-password}"}},
+			},
+			"redis": map[string]interface{}{"password": "${secret:redis-password}", "pool": 10},
+		},
+	}
+	if err := SecretResolver(EnvSecrets("SECRET_"))(m); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"data": map[string]interface{}{
+			"database": map[string]interface{}{
+				"source":   "root:pw@tcp(127.0.0.1:3306)/goweb",
+				"replicas": []interface{}{map[string]interface{}{"source": "pw"}},
+			},
+			"redis": map[string]interface{}{"password": "rpw", "pool": 10},
+		},
+	}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("got %v", m)
+	}
+
+	err := SecretResolver(EnvSecrets("SECRET_"))(map[string]interface{}{
+		"auth": map[string]interface{}{"jwt_key": "${secret:jwt-key}"},
+	})
+	if !errors.Is(err, ErrSecretNotFound) {
+		t.Errorf("err = %v", err)
+	}
+}
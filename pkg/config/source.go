@@ -0,0 +1,231 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	kconfig "github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// layered 按顺序叠加多个配置源。kratos 只把变更的配置合并到当前配置之上，
+// 本地文件修改后会覆盖远程配置；这里任一配置源变更时按顺序返回全部配置源的最新配置，后面的配置源始终覆盖前面的
+type layered struct {
+	sources []kconfig.Source
+
+	mu     sync.Mutex
+	latest [][]*kconfig.KeyValue
+}
+
+func newLayered(sources ...kconfig.Source) kconfig.Source {
+	return &layered{sources: sources, latest: make([][]*kconfig.KeyValue, len(sources))}
+}
+
+func (l *layered) Load() ([]*kconfig.KeyValue, error) {
+	for i, s := range l.sources {
+		kvs, err := s.Load()
+		if err != nil {
+			return nil, err
+		}
+		l.mu.Lock()
+		l.latest[i] = kvs
+		l.mu.Unlock()
+	}
+	return l.snapshot(), nil
+}
+
+// update 用变更的配置替换第 i 个配置源中 key 相同的配置
+func (l *layered) update(i int, kvs []*kconfig.KeyValue) []*kconfig.KeyValue {
+	l.mu.Lock()
+	for _, kv := range kvs {
+		replaced := false
+		for j, old := range l.latest[i] {
+			if old.Key == kv.Key {
+				l.latest[i][j], replaced = kv, true
+				break
+			}
+		}
+		if !replaced {
+			l.latest[i] = append(l.latest[i], kv)
+		}
+	}
+	l.mu.Unlock()
+	return l.snapshot()
+}
+
+func (l *layered) snapshot() []*kconfig.KeyValue {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var all []*kconfig.KeyValue
+	for _, kvs := range l.latest {
+		all = append(all, kvs...)
+	}
+	return all
+}
+
+func (l *layered) Watch() (kconfig.Watcher, error) {
+	w := &layeredWatcher{ch: make(chan []*kconfig.KeyValue), done: make(chan struct{})}
+	for i, s := range l.sources {
+		sw, err := s.Watch()
+		if err != nil {
+			_ = w.Stop()
+			return nil, err
+		}
+		w.watchers = append(w.watchers, sw)
+		go func(i int, sw kconfig.Watcher) {
+			for {
+				kvs, err := sw.Next()
+				if err != nil {
+					select {
+					case <-w.done:
+						return
+					default:
+					}
+					// 单个配置源出错时稍后重试，不影响其他配置源
+					time.Sleep(time.Second)
+					continue
+				}
+				select {
+				case w.ch <- l.update(i, kvs):
+				case <-w.done:
+					return
+				}
+			}
+		}(i, sw)
+	}
+	return w, nil
+}
+
+type layeredWatcher struct {
+	watchers []kconfig.Watcher
+	ch       chan []*kconfig.KeyValue
+	done     chan struct{}
+	once     sync.Once
+}
+
+func (w *layeredWatcher) Next() ([]*kconfig.KeyValue, error) {
+	select {
+	case kvs := <-w.ch:
+		return kvs, nil
+	case <-w.done:
+		return nil, errors.New("config: watcher stopped")
+	}
+}
+
+func (w *layeredWatcher) Stop() error {
+	var err error
+	w.once.Do(func() {
+		close(w.done)
+		for _, sw := range w.watchers {
+			if e := sw.Stop(); e != nil && err == nil {
+				err = e
+			}
+		}
+	})
+	return err
+}
+
+// backup 远程配置源的本地备份：加载成功或收到变更时写入 path，远程加载失败时从 path 读取
+type backup struct {
+	src  kconfig.Source // 为 nil 时表示远程配置源创建失败，只使用备份
+	path string
+	log  *log.Helper
+}
+
+func newBackup(src kconfig.Source, path string, logger log.Logger) kconfig.Source {
+	return &backup{src: src, path: path, log: log.NewHelper(log.With(logger, "module", "pkg/config"))}
+}
+
+func (b *backup) Load() ([]*kconfig.KeyValue, error) {
+	err := errors.New("config: remote source unavailable")
+	if b.src != nil {
+		var kvs []*kconfig.KeyValue
+		if kvs, err = b.src.Load(); err == nil {
+			b.save(kvs, nil)
+			return kvs, nil
+		}
+	}
+	kvs, rerr := b.read()
+	if rerr != nil {
+		return nil, err
+	}
+	b.log.Warnw("msg", "remote config unavailable, load from backup", "path", b.path, "error", err.Error())
+	return kvs, nil
+}
+
+func (b *backup) Watch() (kconfig.Watcher, error) {
+	if b.src == nil {
+		// 没有配置源的 layeredWatcher，Next 阻塞到 Stop
+		return &layeredWatcher{done: make(chan struct{})}, nil
+	}
+	w, err := b.src.Watch()
+	if err != nil {
+		return nil, err
+	}
+	return &backupWatcher{Watcher: w, b: b}, nil
+}
+
+type backupWatcher struct {
+	kconfig.Watcher
+	b *backup
+}
+
+func (w *backupWatcher) Next() ([]*kconfig.KeyValue, error) {
+	kvs, err := w.Watcher.Next()
+	if err == nil {
+		w.b.save(kvs, w.b.readOrEmpty())
+	}
+	return kvs, err
+}
+
+// save 把 kvs 合并到 base 后写入备份，写入失败只记录日志
+func (b *backup) save(kvs, base []*kconfig.KeyValue) {
+	all := append([]*kconfig.KeyValue{}, base...)
+	for _, kv := range kvs {
+		replaced := false
+		for i, old := range all {
+			if old.Key == kv.Key {
+				all[i], replaced = kv, true
+				break
+			}
+		}
+		if !replaced {
+			all = append(all, kv)
+		}
+	}
+	data, err := json.Marshal(all)
+	if err == nil {
+		err = os.MkdirAll(filepath.Dir(b.path), 0o700)
+	}
+	if err == nil {
+		// 先写临时文件再改名，避免进程退出时留下不完整的备份
+		tmp := b.path + ".tmp"
+		if err = os.WriteFile(tmp, data, 0o600); err == nil {
+			err = os.Rename(tmp, b.path)
+		}
+	}
+	if err != nil {
+		b.log.Errorw("msg", "write config backup failed", "path", b.path, "error", err.Error())
+	}
+}
+
+func (b *backup) read() ([]*kconfig.KeyValue, error) {
+	data, err := os.ReadFile(b.path)
+	if err != nil {
+		return nil, err
+	}
+	var kvs []*kconfig.KeyValue
+	if err := json.Unmarshal(data, &kvs); err != nil {
+		return nil, err
+	}
+	return kvs, nil
+}
+
+func (b *backup) readOrEmpty() []*kconfig.KeyValue {
+	kvs, _ := b.read()
+	return kvs
+}
@@ -0,0 +1,99 @@
+package config
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	kconfig "github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type fakeSource struct {
+	kvs []*kconfig.KeyValue
+	err error
+	ch  chan []*kconfig.KeyValue
+}
+
+func (s *fakeSource) Load() ([]*kconfig.KeyValue, error) { return s.kvs, s.err }
+
+func (s *fakeSource) Watch() (kconfig.Watcher, error) { return &fakeWatcher{ch: s.ch}, nil }
+
+type fakeWatcher struct{ ch chan []*kconfig.KeyValue }
+
+func (w *fakeWatcher) Next() ([]*kconfig.KeyValue, error) {
+	kvs, ok := <-w.ch
+	if !ok {
+		return nil, errors.New("stopped")
+	}
+	return kvs, nil
+}
+
+func (w *fakeWatcher) Stop() error { return nil }
+
+func kv(key, value string) *kconfig.KeyValue {
+	return &kconfig.KeyValue{Key: key, Value: []byte(value), Format: "yaml"}
+}
+
+func values(kvs []*kconfig.KeyValue) []string {
+	var out []string
+	for _, kv := range kvs {
+		out = append(out, kv.Key+"="+string(kv.Value))
+	}
+	return out
+}
+
+func TestLayered(t *testing.T) {
+	local := &fakeSource{kvs: []*kconfig.KeyValue{kv("config.yaml", "a")}, ch: make(chan []*kconfig.KeyValue)}
+	remote := &fakeSource{kvs: []*kconfig.KeyValue{kv("ns1.yaml", "b"), kv("ns2.yaml", "c")}, ch: make(chan []*kconfig.KeyValue)}
+	l := newLayered(local, remote)
+	kvs, err := l.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := values(kvs); len(got) != 3 || got[0] != "config.yaml=a" {
+		t.Fatalf("got %v", got)
+	}
+
+	w, err := l.Watch()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+	// 本地文件变更后仍返回全部远程配置，且远程配置在后面
+	local.ch <- []*kconfig.KeyValue{kv("config.yaml", "a2")}
+	kvs, _ = w.Next()
+	if got := values(kvs); len(got) != 3 || got[0] != "config.yaml=a2" || got[2] != "ns2.yaml=c" {
+		t.Errorf("got %v", got)
+	}
+	remote.ch <- []*kconfig.KeyValue{kv("ns1.yaml", "b2")}
+	kvs, _ = w.Next()
+	if got := values(kvs); len(got) != 3 || got[1] != "ns1.yaml=b2" || got[2] != "ns2.yaml=c" {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestBackup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "apollo-app.json")
+	remote := &fakeSource{kvs: []*kconfig.KeyValue{kv("ns1.yaml", "b")}}
+	if _, err := newBackup(remote, path, log.DefaultLogger).Load(); err != nil {
+		t.Fatal(err)
+	}
+
+	// 远程不可用或创建失败时从备份加载
+	remote.err = errors.New("connection refused")
+	for _, src := range []kconfig.Source{newBackup(remote, path, log.DefaultLogger), newBackup(nil, path, log.DefaultLogger)} {
+		kvs, err := src.Load()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := values(kvs); len(got) != 1 || got[0] != "ns1.yaml=b" {
+			t.Errorf("got %v", got)
+		}
+	}
+
+	// 没有备份时返回远程的错误
+	if _, err := newBackup(remote, path+".missing", log.DefaultLogger).Load(); err != remote.err {
+		t.Errorf("err = %v", err)
+	}
+}
@@ -0,0 +1,283 @@
+/*
+ * @PackageName: dbrouter
+ * @Description: [GORM] 读写分离路由
+ * 写操作与事务内的读走主库，其余读操作轮询健康的从库：
+ *   r := dbrouter.New(primary, replicas, dbrouter.WithReadYourWrites(time.Second))
+ *   r.Write(dbrouter.WithKey(ctx, "user:1")).Create(u)
+ *   r.Read(dbrouter.WithKey(ctx, "user:1")).First(&u, 1) // 窗口期内读主库
+ * 写后读窗口记录在进程内存中，只保证同一实例内读到自己的写入；多实例部署时，
+ * 写入后的下一次请求被负载均衡到其他实例仍可能读到延迟的从库，需要强一致的读取使用 WithPrimary
+ */
+package dbrouter
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"casso/pkg/util/transaction"
+
+	"gorm.io/gorm"
+)
+
+type ctxKey int
+
+const (
+	keyRYW ctxKey = iota
+	keyPrimary
+)
+
+// WithKey 设置写后读的标识（如用户ID），同一标识写入后的窗口期内读主库
+func WithKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, keyRYW, key)
+}
+
+// KeyFromContext 获取 WithKey 设置的标识
+func KeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(keyRYW).(string)
+	return key
+}
+
+// WithPrimary 强制本次请求的读操作走主库
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, keyPrimary, true)
+}
+
+// KeyFunc 从 context 中提取写后读标识，返回空字符串表示不跟踪
+type KeyFunc func(ctx context.Context) string
+
+// Option 路由配置
+type Option func(*Router)
+
+// WithReadYourWrites 写后读窗口，为 0 时不跟踪写入
+func WithReadYourWrites(d time.Duration) Option {
+	return func(r *Router) {
+		r.window = d
+	}
+}
+
+// WithKeyFunc 自定义写后读标识，默认使用 KeyFromContext
+func WithKeyFunc(fn KeyFunc) Option {
+	return func(r *Router) {
+		r.keyFunc = fn
+	}
+}
+
+// WithHealthCheck 从库健康检查间隔与单次 ping 超时，interval 为 0 时不检查
+func WithHealthCheck(interval, timeout time.Duration) Option {
+	return func(r *Router) {
+		r.interval = interval
+		r.timeout = timeout
+	}
+}
+
+// WithOnStateChange 从库被剔除或恢复时回调，用于记录日志
+func WithOnStateChange(fn func(index int, healthy bool, err error)) Option {
+	return func(r *Router) {
+		r.onChange = fn
+	}
+}
+
+type replica struct {
+	db      *gorm.DB
+	healthy int32
+}
+
+// Router 读写分离路由
+type Router struct {
+	primary  *gorm.DB
+	replicas []*replica
+	next     uint32
+
+	window  time.Duration
+	keyFunc KeyFunc
+	mu      sync.Mutex
+	writes  map[string]time.Time
+
+	interval time.Duration
+	timeout  time.Duration
+	onChange func(index int, healthy bool, err error)
+	stop     chan struct{}
+	done     chan struct{}
+	once     sync.Once
+}
+
+// New 新建路由，replicas 为空时读写都走主库；开启健康检查时先 ping 一次从库，不可用的从库初始即被剔除
+func New(primary *gorm.DB, replicas []*gorm.DB, opts ...Option) *Router {
+	r := &Router{
+		primary:  primary,
+		keyFunc:  KeyFromContext,
+		writes:   make(map[string]time.Time),
+		interval: 5 * time.Second,
+		timeout:  time.Second,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	for _, o := range opts {
+		o(r)
+	}
+	for _, db := range replicas {
+		r.replicas = append(r.replicas, &replica{db: db, healthy: 1})
+	}
+	if len(r.replicas) > 0 && r.interval > 0 {
+		// 启动时先检查一次，不可用的从库直接剔除，不等第一次定时检查
+		r.check()
+		go r.healthLoop()
+	} else {
+		close(r.done)
+	}
+	return r
+}
+
+// Primary 主库连接
+func (r *Router) Primary() *gorm.DB {
+	return r.primary
+}
+
+// Write 获取写连接：处于事务中时返回事务连接，否则返回主库；同时记录写后读窗口
+func (r *Router) Write(ctx context.Context) *gorm.DB {
+	if key := r.keyFunc(ctx); key != "" {
+		r.Touch(key)
+		// 长事务提交时窗口可能已过期，提交后重新记录
+		if _, ok := transaction.FromContext(ctx); ok {
+			transaction.AfterCommit(ctx, func(context.Context) { r.Touch(key) })
+		}
+	}
+	if tx, ok := transaction.FromContext(ctx); ok {
+		return tx
+	}
+	return r.primary.WithContext(ctx)
+}
+
+// Read 获取读连接：事务内、强制主库、写后读窗口内或没有健康从库时返回主库
+func (r *Router) Read(ctx context.Context) *gorm.DB {
+	if tx, ok := transaction.FromContext(ctx); ok {
+		return tx
+	}
+	if force, _ := ctx.Value(keyPrimary).(bool); force {
+		return r.primary.WithContext(ctx)
+	}
+	if key := r.keyFunc(ctx); key != "" && r.recentlyWritten(key) {
+		return r.primary.WithContext(ctx)
+	}
+	if db := r.pick(); db != nil {
+		return db.WithContext(ctx)
+	}
+	return r.primary.WithContext(ctx)
+}
+
+// Touch 记录 key 刚发生写入，窗口期内该 key 的读操作走主库
+func (r *Router) Touch(key string) {
+	if r.window <= 0 || key == "" {
+		return
+	}
+	now := time.Now()
+	r.mu.Lock()
+	r.writes[key] = now.Add(r.window)
+	// 顺带清理过期记录，避免 map 无限增长
+	if len(r.writes) > 1024 {
+		for k, deadline := range r.writes {
+			if now.After(deadline) {
+				delete(r.writes, k)
+			}
+		}
+	}
+	r.mu.Unlock()
+}
+
+func (r *Router) recentlyWritten(key string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	deadline, ok := r.writes[key]
+	if !ok {
+		return false
+	}
+	if time.Now().After(deadline) {
+		delete(r.writes, key)
+		return false
+	}
+	return true
+}
+
+// pick 轮询选择健康的从库
+func (r *Router) pick() *gorm.DB {
+	n := len(r.replicas)
+	if n == 0 {
+		return nil
+	}
+	start := atomic.AddUint32(&r.next, 1)
+	for i := 0; i < n; i++ {
+		rep := r.replicas[(int(start)+i)%n]
+		if atomic.LoadInt32(&rep.healthy) == 1 {
+			return rep.db
+		}
+	}
+	return nil
+}
+
+// Healthy 返回各从库的健康状态
+func (r *Router) Healthy() []bool {
+	res := make([]bool, len(r.replicas))
+	for i, rep := range r.replicas {
+		res[i] = atomic.LoadInt32(&rep.healthy) == 1
+	}
+	return res
+}
+
+func (r *Router) healthLoop() {
+	defer close(r.done)
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			r.check()
+		}
+	}
+}
+
+// check ping 所有从库，失败的从库被剔除，恢复后重新加入
+func (r *Router) check() {
+	for i, rep := range r.replicas {
+		err := r.ping(rep.db)
+		healthy := int32(1)
+		if err != nil {
+			healthy = 0
+		}
+		if atomic.SwapInt32(&rep.healthy, healthy) != healthy && r.onChange != nil {
+			r.onChange(i, healthy == 1, err)
+		}
+	}
+}
+
+func (r *Router) ping(db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+	return sqlDB.PingContext(ctx)
+}
+
+// Close 停止健康检查并关闭从库连接，主库由调用方关闭
+func (r *Router) Close() error {
+	var err error
+	r.once.Do(func() {
+		close(r.stop)
+		<-r.done
+		for _, rep := range r.replicas {
+			sqlDB, e := rep.db.DB()
+			if e == nil {
+				e = sqlDB.Close()
+			}
+			if e != nil && err == nil {
+				err = e
+			}
+		}
+	})
+	return err
+}
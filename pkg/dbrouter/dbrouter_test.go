@@ -0,0 +1,171 @@
+package dbrouter
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// fakeDriver 只用于 ping，dsn 为 down 时连接失败
+type fakeDriver struct {
+	mu   sync.Mutex
+	down map[string]bool
+}
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not implemented") }
+func (fakeConn) Close() error                        { return nil }
+func (fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not implemented") }
+
+func (d *fakeDriver) Open(dsn string) (driver.Conn, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.down[dsn] {
+		return nil, errors.New("connection refused")
+	}
+	return fakeConn{}, nil
+}
+
+func (d *fakeDriver) setDown(dsn string, down bool) {
+	d.mu.Lock()
+	d.down[dsn] = down
+	d.mu.Unlock()
+}
+
+var fake = &fakeDriver{down: map[string]bool{}}
+
+func init() {
+	sql.Register("dbrouter-fake", fake)
+}
+
+func open(t *testing.T, dsn string) *gorm.DB {
+	sqlDB, err := sql.Open("dbrouter-fake", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 失败的连接不复用，保证每次 ping 都重新拨号
+	sqlDB.SetMaxIdleConns(0)
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func same(a, b *gorm.DB) bool {
+	return a.Statement.ConnPool == b.Statement.ConnPool
+}
+
+func TestReadWriteRouting(t *testing.T) {
+	primary, r1, r2 := open(t, "p"), open(t, "r1"), open(t, "r2")
+	r := New(primary, []*gorm.DB{r1, r2}, WithReadYourWrites(time.Hour), WithHealthCheck(0, 0))
+	defer r.Close()
+	ctx := context.Background()
+
+	if !same(r.Write(ctx), primary) {
+		t.Fatal("write should go to primary")
+	}
+	seen := map[gorm.ConnPool]bool{}
+	for i := 0; i < 4; i++ {
+		db := r.Read(ctx)
+		if same(db, primary) {
+			t.Fatal("read should go to a replica")
+		}
+		seen[db.Statement.ConnPool] = true
+	}
+	if len(seen) != 2 {
+		t.Fatalf("reads should be spread over both replicas, got %d", len(seen))
+	}
+
+	if !same(r.Read(WithPrimary(ctx)), primary) {
+		t.Fatal("forced read should go to primary")
+	}
+
+	uctx := WithKey(ctx, "user:1")
+	r.Write(uctx)
+	if !same(r.Read(uctx), primary) {
+		t.Fatal("read after own write should go to primary")
+	}
+	if same(r.Read(WithKey(ctx, "user:2")), primary) {
+		t.Fatal("other keys should still read from replicas")
+	}
+}
+
+func TestReadYourWritesExpires(t *testing.T) {
+	primary, r1 := open(t, "p"), open(t, "r1")
+	r := New(primary, []*gorm.DB{r1}, WithReadYourWrites(20*time.Millisecond), WithHealthCheck(0, 0))
+	defer r.Close()
+
+	ctx := WithKey(context.Background(), "user:1")
+	r.Touch("user:1")
+	if !same(r.Read(ctx), primary) {
+		t.Fatal("read inside window should go to primary")
+	}
+	time.Sleep(30 * time.Millisecond)
+	if !same(r.Read(ctx), r1) {
+		t.Fatal("read after window should go to replica")
+	}
+}
+
+func TestEjectUnhealthyReplica(t *testing.T) {
+	primary, r1, r2 := open(t, "p"), open(t, "eject-r1"), open(t, "eject-r2")
+	changes := make(chan bool, 4)
+	r := New(primary, []*gorm.DB{r1, r2},
+		WithHealthCheck(0, time.Second),
+		WithOnStateChange(func(i int, healthy bool, err error) { changes <- healthy }),
+	)
+	defer r.Close()
+	ctx := context.Background()
+
+	fake.setDown("eject-r1", true)
+	r.check()
+	if h := r.Healthy(); h[0] || !h[1] {
+		t.Fatalf("healthy = %v", h)
+	}
+	for i := 0; i < 4; i++ {
+		if !same(r.Read(ctx), r2) {
+			t.Fatal("ejected replica should not receive reads")
+		}
+	}
+
+	fake.setDown("eject-r2", true)
+	r.check()
+	if !same(r.Read(ctx), primary) {
+		t.Fatal("should fall back to primary without healthy replicas")
+	}
+
+	fake.setDown("eject-r1", false)
+	fake.setDown("eject-r2", false)
+	r.check()
+	if h := r.Healthy(); !h[0] || !h[1] {
+		t.Fatalf("replicas should rejoin, healthy = %v", h)
+	}
+	if len(changes) != 4 {
+		t.Fatalf("got %d state changes, want 4", len(changes))
+	}
+}
+
+func TestInitialPingEjectsDeadReplica(t *testing.T) {
+	primary, r1, r2 := open(t, "p"), open(t, "init-r1"), open(t, "init-r2")
+	fake.setDown("init-r1", true)
+	defer fake.setDown("init-r1", false)
+	r := New(primary, []*gorm.DB{r1, r2}, WithHealthCheck(time.Hour, time.Second))
+	defer r.Close()
+
+	if h := r.Healthy(); h[0] || !h[1] {
+		t.Fatalf("healthy = %v, dead replica should start ejected", h)
+	}
+	for i := 0; i < 4; i++ {
+		if !same(r.Read(context.Background()), r2) {
+			t.Fatal("dead replica should not receive reads before the first tick")
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 错误登记代码生成，在仓库根目录执行:
+//
+//	go run ./pkg/errors/cmd/errgen            扫描并生成 pkg/errors/registry_gen.go
+//	go run ./pkg/errors/cmd/errgen -check     只检查 Reason 是否重复，用于 CI
+//
+// 扫描 api 下 proto 的错误枚举，以及 pkg 下直接调用 kratos errors.New 定义的错误，
+// 任意两个服务定义了相同的 Reason 时退出码为 1
+var (
+	root  string
+	out   string
+	check bool
+)
+
+const kratosErrors = "github.com/go-kratos/kratos/v2/errors"
+
+func init() {
+	flag.StringVar(&root, "root", ".", "repository root")
+	flag.StringVar(&out, "out", "pkg/errors/registry_gen.go", "generated file, relative to root")
+	flag.BoolVar(&check, "check", false, "only check reasons are unique, do not write")
+}
+
+type definition struct {
+	Reason string
+	Code   int
+	Domain string
+	Source string
+}
+
+func main() {
+	flag.Parse()
+
+	defs, err := scanProtos(filepath.Join(root, "api"))
+	if err != nil {
+		fail(err)
+	}
+	goDefs, err := scanGo(filepath.Join(root, "pkg"))
+	if err != nil {
+		fail(err)
+	}
+	defs = append(defs, goDefs...)
+
+	if conflicts := duplicates(defs); len(conflicts) > 0 {
+		for _, c := range conflicts {
+			fmt.Fprintln(os.Stderr, c)
+		}
+		os.Exit(1)
+	}
+	if check {
+		fmt.Printf("%d reasons, no duplicates\n", len(defs))
+		return
+	}
+
+	src, err := generate(defs)
+	if err != nil {
+		fail(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(root, out), src, 0644); err != nil {
+		fail(err)
+	}
+	fmt.Printf("wrote %d reasons to %s\n", len(defs), out)
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "errgen:", err)
+	os.Exit(1)
+}
+
+var (
+	protoPackage = regexp.MustCompile(`^package\s+([\w.]+)\s*;`)
+	protoDefault = regexp.MustCompile(`option\s+\(errors\.default_code\)\s*=\s*(\d+)`)
+	protoValue   = regexp.MustCompile(`^([A-Z][A-Z0-9_]*)\s*=\s*\d+\s*(?:\[\s*\(errors\.code\)\s*=\s*(\d+)\s*\])?\s*;`)
+)
+
+// scanProtos 只处理声明了 errors.default_code 的枚举
+func scanProtos(dir string) ([]definition, error) {
+	var defs []definition
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Ext(path) != ".proto" {
+			return err
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		var (
+			domain      string
+			inEnum      bool
+			defaultCode int
+			values      []definition
+		)
+		rel, _ := filepath.Rel(root, path)
+		sc := bufio.NewScanner(f)
+		for sc.Scan() {
+			line := strings.TrimSpace(sc.Text())
+			if m := protoPackage.FindStringSubmatch(line); m != nil {
+				// api.user.service.v1 -> user
+				if parts := strings.Split(m[1], "."); len(parts) > 1 {
+					domain = parts[1]
+				}
+				continue
+			}
+			if strings.HasPrefix(line, "enum ") {
+				inEnum, defaultCode, values = true, 0, nil
+				continue
+			}
+			if !inEnum {
+				continue
+			}
+			if m := protoDefault.FindStringSubmatch(line); m != nil {
+				defaultCode, _ = strconv.Atoi(m[1])
+				continue
+			}
+			if m := protoValue.FindStringSubmatch(line); m != nil {
+				code, _ := strconv.Atoi(m[2])
+				values = append(values, definition{Reason: m[1], Code: code, Domain: domain, Source: filepath.ToSlash(rel)})
+				continue
+			}
+			if strings.HasPrefix(line, "}") {
+				if defaultCode > 0 {
+					for _, v := range values {
+						if v.Code == 0 {
+							v.Code = defaultCode
+						}
+						defs = append(defs, v)
+					}
+				}
+				inEnum = false
+			}
+		}
+		return sc.Err()
+	})
+	return defs, err
+}
+
+// scanGo 查找 errors.New(code, "REASON", ...) 形式的调用，code 与 reason 需为字面量
+func scanGo(dir string) ([]definition, error) {
+	self, _ := filepath.Abs(filepath.Join(root, out))
+	var defs []definition
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == "cmd" || info.Name() == "testdata" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) != ".go" || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		if abs, _ := filepath.Abs(path); abs == self {
+			return nil
+		}
+
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, path, nil, parser.ImportsOnly)
+		if err != nil {
+			return err
+		}
+		name := importName(file)
+		if name == "" {
+			return nil
+		}
+		file, err = parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+
+		rel, _ := filepath.Rel(root, path)
+		domain := filepath.Base(filepath.Dir(path))
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) < 2 {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "New" {
+				return true
+			}
+			if x, ok := sel.X.(*ast.Ident); !ok || x.Name != name {
+				return true
+			}
+			codeLit, ok := call.Args[0].(*ast.BasicLit)
+			if !ok || codeLit.Kind != token.INT {
+				return true
+			}
+			reasonLit, ok := call.Args[1].(*ast.BasicLit)
+			if !ok || reasonLit.Kind != token.STRING {
+				return true
+			}
+			code, _ := strconv.Atoi(codeLit.Value)
+			reason, _ := strconv.Unquote(reasonLit.Value)
+			defs = append(defs, definition{Reason: reason, Code: code, Domain: domain, Source: filepath.ToSlash(rel)})
+			return true
+		})
+		return nil
+	})
+	return defs, err
+}
+
+func importName(file *ast.File) string {
+	for _, imp := range file.Imports {
+		if p, _ := strconv.Unquote(imp.Path.Value); p == kratosErrors {
+			if imp.Name != nil {
+				return imp.Name.Name
+			}
+			return "errors"
+		}
+	}
+	return ""
+}
+
+func duplicates(defs []definition) []string {
+	byReason := map[string][]definition{}
+	for _, d := range defs {
+		byReason[d.Reason] = append(byReason[d.Reason], d)
+	}
+	var conflicts []string
+	for reason, ds := range byReason {
+		if len(ds) < 2 {
+			continue
+		}
+		var where []string
+		for _, d := range ds {
+			where = append(where, fmt.Sprintf("%s(%s)", d.Domain, d.Source))
+		}
+		conflicts = append(conflicts, fmt.Sprintf("duplicate reason %q: %s", reason, strings.Join(where, ", ")))
+	}
+	sort.Strings(conflicts)
+	return conflicts
+}
+
+func generate(defs []definition) ([]byte, error) {
+	sort.Slice(defs, func(i, j int) bool {
+		if defs[i].Domain != defs[j].Domain {
+			return defs[i].Domain < defs[j].Domain
+		}
+		return defs[i].Reason < defs[j].Reason
+	})
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by errgen. DO NOT EDIT.\n\n")
+	buf.WriteString("package errors\n\n")
+	buf.WriteString("func init() {\n\tRegister(\n")
+	for _, d := range defs {
+		fmt.Fprintf(&buf, "\t\tDefinition{Reason: %q, Code: %d, Domain: %q, Source: %q},\n", d.Reason, d.Code, d.Domain, d.Source)
+	}
+	buf.WriteString("\t)\n}\n")
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,134 @@
+package errors
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// Rule 下游错误到对外错误的映射规则
+type Rule struct {
+	Reason    string        // 下游错误 Reason
+	Operation string        // 仅对该接口生效，为空时对所有接口生效
+	To        *errors.Error // 对外错误，Message 作为缺省提示；为 nil 时保留原 Reason 透出
+	Metadata  []string      // 允许透出的 metadata key，其余一律丢弃
+}
+
+// Mapper BFF 对外错误映射
+// 命中规则的错误按规则转换，Domain 属于对外服务的错误去掉 cause 后透出，其余错误统一返回 fallback，
+// 避免下游的 Reason、Message、metadata 泄露给客户端
+type Mapper struct {
+	rules    map[string]Rule
+	domains  map[string]bool
+	fallback *errors.Error
+}
+
+// MapperOption 映射配置
+type MapperOption func(*Mapper)
+
+// WithPublicDomains 这些 Domain 下的错误视为对外错误，如 BFF 自身的 shop
+func WithPublicDomains(domains ...string) MapperOption {
+	return func(m *Mapper) {
+		for _, d := range domains {
+			m.domains[d] = true
+		}
+	}
+}
+
+// WithFallback 未声明映射的错误统一返回的错误，默认 UnknownError
+func WithFallback(err *errors.Error) MapperOption {
+	return func(m *Mapper) {
+		m.fallback = err
+	}
+}
+
+// NewMapper 同一接口下重复声明的 Reason 以后声明的为准
+func NewMapper(rules []Rule, opts ...MapperOption) *Mapper {
+	m := &Mapper{
+		rules:    make(map[string]Rule, len(rules)),
+		domains:  map[string]bool{},
+		fallback: UnknownError,
+	}
+	for _, r := range rules {
+		m.rules[ruleKey(r.Operation, r.Reason)] = r
+	}
+	for _, o := range opts {
+		o(m)
+	}
+	return m
+}
+
+func ruleKey(operation, reason string) string {
+	return operation + " " + reason
+}
+
+// Map 将 operation 接口返回的错误转换为对外错误，nil 原样返回
+func (m *Mapper) Map(operation string, err error) *errors.Error {
+	if err == nil {
+		return nil
+	}
+	e := errors.FromError(err)
+
+	r, ok := m.rules[ruleKey(operation, e.Reason)]
+	if !ok {
+		r, ok = m.rules[ruleKey("", e.Reason)]
+	}
+	if ok {
+		to := r.To
+		if to == nil {
+			to = e
+		}
+		out := errors.New(int(to.Code), to.Reason, to.Message)
+		if md := pick(e.Metadata, r.Metadata); len(md) > 0 {
+			out = out.WithMetadata(md)
+		}
+		return out
+	}
+
+	if d, ok := Lookup(e.Reason); ok && m.domains[d.Domain] {
+		out := errors.New(int(e.Code), e.Reason, e.Message)
+		if len(e.Metadata) > 0 {
+			out = out.WithMetadata(e.Metadata)
+		}
+		return out
+	}
+	return errors.New(int(m.fallback.Code), m.fallback.Reason, m.fallback.Message)
+}
+
+func pick(md map[string]string, keys []string) map[string]string {
+	if len(md) == 0 || len(keys) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(keys))
+	for _, k := range keys {
+		if v, ok := md[k]; ok {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// Server 服务端错误映射中间件，需放在 i18n 中间件之内，被替换的原始错误记录到日志
+func Server(m *Mapper, logger log.Logger) middleware.Middleware {
+	helper := log.NewHelper(log.With(logger, "module", "errors/mapping"))
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			reply, err := handler(ctx, req)
+			if err == nil {
+				return reply, nil
+			}
+			var operation string
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				operation = tr.Operation()
+			}
+			out := m.Map(operation, err)
+			if out.Reason != errors.Reason(err) {
+				helper.WithContext(ctx).Warnf("[%s] %v mapped to %s", operation, err, out.Reason)
+			}
+			return reply, out
+		}
+	}
+}
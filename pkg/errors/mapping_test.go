@@ -0,0 +1,80 @@
+package errors
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestMapperMap(t *testing.T) {
+	notFound := errors.New(404, "PUBLIC_NOT_FOUND", "not found")
+	loginFailed := errors.New(400, "PUBLIC_LOGIN_FAILED", "login failed")
+	internal := errors.New(500, "PUBLIC_INTERNAL", "busy")
+	Register(
+		Definition{Reason: "PUBLIC_NOT_FOUND", Code: 404, Domain: "public", Source: "test"},
+		Definition{Reason: "DOWNSTREAM_NOT_FOUND", Code: 404, Domain: "downstream", Source: "test"},
+	)
+
+	m := NewMapper([]Rule{
+		{Reason: "DOWNSTREAM_NOT_FOUND", To: notFound, Metadata: []string{"field"}},
+		{Reason: "DOWNSTREAM_NOT_FOUND", Operation: "/login", To: loginFailed},
+		{Reason: "RATELIMIT"},
+	}, WithPublicDomains("public"), WithFallback(internal))
+
+	downstream := errors.New(404, "DOWNSTREAM_NOT_FOUND", "select * from user: no rows").
+		WithMetadata(map[string]string{"field": "mobile", "sql": "select"}).
+		WithCause(fmt.Errorf("record not found"))
+
+	cases := []struct {
+		name      string
+		operation string
+		err       error
+		reason    string
+		code      int32
+		metadata  map[string]string
+	}{
+		{"rule", "/get", downstream, "PUBLIC_NOT_FOUND", 404, map[string]string{"field": "mobile"}},
+		{"operation rule", "/login", downstream, "PUBLIC_LOGIN_FAILED", 400, nil},
+		{"pass through", "/get", errors.New(429, "RATELIMIT", "limit"), "RATELIMIT", 429, nil},
+		{"public domain", "/get", notFound.WithCause(fmt.Errorf("internal")), "PUBLIC_NOT_FOUND", 404, nil},
+		{"unknown reason", "/get", errors.New(400, "OTHER", "secret"), "PUBLIC_INTERNAL", 500, nil},
+		{"plain error", "/get", fmt.Errorf("dial tcp: refused"), "PUBLIC_INTERNAL", 500, nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			out := m.Map(c.operation, c.err)
+			if out.Reason != c.reason || out.Code != c.code {
+				t.Fatalf("got %d %s, want %d %s", out.Code, out.Reason, c.code, c.reason)
+			}
+			if out.Unwrap() != nil {
+				t.Fatalf("cause leaked: %v", out.Unwrap())
+			}
+			if len(out.Metadata) != len(c.metadata) {
+				t.Fatalf("metadata = %v, want %v", out.Metadata, c.metadata)
+			}
+			for k, v := range c.metadata {
+				if out.Metadata[k] != v {
+					t.Fatalf("metadata = %v, want %v", out.Metadata, c.metadata)
+				}
+			}
+		})
+	}
+
+	if m.Map("/get", nil) != nil {
+		t.Fatal("nil error should stay nil")
+	}
+}
+
+func TestRegisterDuplicate(t *testing.T) {
+	Register(Definition{Reason: "DUP_REASON", Code: 400, Domain: "a", Source: "a.proto"})
+	// 相同定义重复登记不报错
+	Register(Definition{Reason: "DUP_REASON", Code: 400, Domain: "a", Source: "a.proto"})
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic on conflicting definition")
+		}
+	}()
+	Register(Definition{Reason: "DUP_REASON", Code: 400, Domain: "b", Source: "b.proto"})
+}
@@ -0,0 +1,60 @@
+package errors
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Definition 一个错误 Reason 的登记信息
+// proto 枚举与 pkg 中直接定义的 kratos 错误统一由 errgen 扫描生成到 registry_gen.go，
+// Reason 在所有服务之间唯一
+type Definition struct {
+	Reason string
+	Code   int
+	Domain string // 所属服务或包，如 user、shop、idempotent
+	Source string // 定义所在文件
+}
+
+var registry = struct {
+	sync.RWMutex
+	defs map[string]Definition
+}{defs: map[string]Definition{}}
+
+// Register 登记错误定义，同一 Reason 重复登记且定义不一致时 panic
+func Register(defs ...Definition) {
+	registry.Lock()
+	defer registry.Unlock()
+	for _, d := range defs {
+		if old, ok := registry.defs[d.Reason]; ok && old != d {
+			panic(fmt.Sprintf("errors: reason %q defined in both %s and %s", d.Reason, old.Source, d.Source))
+		}
+		registry.defs[d.Reason] = d
+	}
+}
+
+// Lookup 按 Reason 查找错误定义
+func Lookup(reason string) (Definition, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	d, ok := registry.defs[reason]
+	return d, ok
+}
+
+// Definitions 返回全部错误定义，按 Domain、Reason 排序
+func Definitions() []Definition {
+	registry.RLock()
+	defs := make([]Definition, 0, len(registry.defs))
+	for _, d := range registry.defs {
+		defs = append(defs, d)
+	}
+	registry.RUnlock()
+
+	sort.Slice(defs, func(i, j int) bool {
+		if defs[i].Domain != defs[j].Domain {
+			return defs[i].Domain < defs[j].Domain
+		}
+		return defs[i].Reason < defs[j].Reason
+	})
+	return defs
+}
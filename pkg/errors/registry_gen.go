@@ -0,0 +1,41 @@
+// Code generated by errgen. DO NOT EDIT.
+
+package errors
+
+func init() {
+	Register(
+		Definition{Reason: "BOX_CAMPAIGN_NOT_ACTIVE", Code: 400, Domain: "box", Source: "api/box/service/v1/box_error.proto"},
+		Definition{Reason: "BOX_CAMPAIGN_NOT_FOUND", Code: 404, Domain: "box", Source: "api/box/service/v1/box_error.proto"},
+		Definition{Reason: "BOX_INVALID_PARAMS", Code: 400, Domain: "box", Source: "api/box/service/v1/box_error.proto"},
+		Definition{Reason: "BOX_POOL_INVALID", Code: 400, Domain: "box", Source: "api/box/service/v1/box_error.proto"},
+		Definition{Reason: "BOX_POOL_LOCKED", Code: 400, Domain: "box", Source: "api/box/service/v1/box_error.proto"},
+		Definition{Reason: "BOX_SOLD_OUT", Code: 400, Domain: "box", Source: "api/box/service/v1/box_error.proto"},
+		Definition{Reason: "BOX_TOO_MANY", Code: 400, Domain: "box", Source: "api/box/service/v1/box_error.proto"},
+		Definition{Reason: "Authentication failed", Code: 401, Domain: "errors", Source: "pkg/errors/user.go"},
+		Definition{Reason: "InvalidParams", Code: 400, Domain: "errors", Source: "pkg/errors/shop.go"},
+		Definition{Reason: "MakeTokenFailed", Code: 500, Domain: "errors", Source: "pkg/errors/shop.go"},
+		Definition{Reason: "RecordNotFound", Code: 404, Domain: "errors", Source: "pkg/errors/shop.go"},
+		Definition{Reason: "UnknownError", Code: 500, Domain: "errors", Source: "pkg/errors/shop.go"},
+		Definition{Reason: "UserNotExist", Code: 400, Domain: "errors", Source: "pkg/errors/shop.go"},
+		Definition{Reason: "IDEMPOTENT_IN_PROGRESS", Code: 409, Domain: "idempotent", Source: "pkg/idempotent/idempotent.go"},
+		Definition{Reason: "IDEMPOTENT_LOCK_LOST", Code: 409, Domain: "idempotent", Source: "pkg/idempotent/idempotent.go"},
+		Definition{Reason: "IDEMPOTENT_MISSING_KEY", Code: 400, Domain: "idempotent", Source: "pkg/idempotent/idempotent.go"},
+		Definition{Reason: "SHOP_BOX_SOLD_OUT", Code: 400, Domain: "shop", Source: "api/shop/service/v1/shop_error.proto"},
+		Definition{Reason: "SHOP_BOX_TOO_MANY", Code: 400, Domain: "shop", Source: "api/shop/service/v1/shop_error.proto"},
+		Definition{Reason: "SHOP_BOX_UNAVAILABLE", Code: 400, Domain: "shop", Source: "api/shop/service/v1/shop_error.proto"},
+		Definition{Reason: "SHOP_CONTENT_MISSING", Code: 400, Domain: "shop", Source: "api/shop/service/v1/shop_error.proto"},
+		Definition{Reason: "SHOP_DUPLICATE_ENTRY", Code: 400, Domain: "shop", Source: "api/shop/service/v1/shop_error.proto"},
+		Definition{Reason: "SHOP_INTERNAL_ERROR", Code: 500, Domain: "shop", Source: "api/shop/service/v1/shop_error.proto"},
+		Definition{Reason: "SHOP_INVALID_PARAMS", Code: 400, Domain: "shop", Source: "api/shop/service/v1/shop_error.proto"},
+		Definition{Reason: "SHOP_INVALID_TOKEN", Code: 401, Domain: "shop", Source: "api/shop/service/v1/shop_error.proto"},
+		Definition{Reason: "SHOP_LOGIN_FAILED", Code: 400, Domain: "shop", Source: "api/shop/service/v1/shop_error.proto"},
+		Definition{Reason: "SHOP_PERMITION_DENIED", Code: 401, Domain: "shop", Source: "api/shop/service/v1/shop_error.proto"},
+		Definition{Reason: "SHOP_RECORD_NOT_FOUND", Code: 404, Domain: "shop", Source: "api/shop/service/v1/shop_error.proto"},
+		Definition{Reason: "USER_CONTENT_MISSING", Code: 400, Domain: "user", Source: "api/user/service/v1/user_error.proto"},
+		Definition{Reason: "USER_DUPLICATE_ENTRY", Code: 400, Domain: "user", Source: "api/user/service/v1/user_error.proto"},
+		Definition{Reason: "USER_INVALID_PARAMS", Code: 400, Domain: "user", Source: "api/user/service/v1/user_error.proto"},
+		Definition{Reason: "USER_INVALID_PASS", Code: 400, Domain: "user", Source: "api/user/service/v1/user_error.proto"},
+		Definition{Reason: "USER_MAKE_TOKEN_ERROR", Code: 500, Domain: "user", Source: "api/user/service/v1/user_error.proto"},
+		Definition{Reason: "USER_RECORD_NOT_FOUND", Code: 404, Domain: "user", Source: "api/user/service/v1/user_error.proto"},
+	)
+}
@@ -3,10 +3,18 @@ package errors
 import "github.com/go-kratos/kratos/v2/errors"
 
 // 如果无需客户端做多语言兼容,可以在此定义固定的Reason 跟 Message
+// 需要多语言时在 pkg/i18n/locales 中按 Reason 添加翻译，Message 作为缺省值
 var (
-	InvalidParams  = errors.New(400, "InvalidParams", "Missing Params")
-	UserNotExit    = errors.New(400, "UserNotExit", "User Not Exit")
-	RecordNotFound = errors.New(404, "RecordNotFound", "Record Not Found")
-	UnknownError   = errors.New(500, "UnknownError", "Unknown Errors")
-	MakeTokenFaild = errors.New(500, "MakeTokenFaild", "Make Token Faild")
+	InvalidParams   = errors.New(400, "InvalidParams", "Missing Params")
+	UserNotExist    = errors.New(400, "UserNotExist", "User Not Exist")
+	RecordNotFound  = errors.New(404, "RecordNotFound", "Record Not Found")
+	UnknownError    = errors.New(500, "UnknownError", "Unknown Errors")
+	MakeTokenFailed = errors.New(500, "MakeTokenFailed", "Make Token Failed")
+)
+
+var (
+	// Deprecated: 拼写错误，使用 UserNotExist
+	UserNotExit = UserNotExist
+	// Deprecated: 拼写错误，使用 MakeTokenFailed
+	MakeTokenFaild = MakeTokenFailed
 )
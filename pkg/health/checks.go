@@ -0,0 +1,74 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"gorm.io/gorm"
+)
+
+// GORM 检查数据库连接
+func GORM(db *gorm.DB) CheckFunc {
+	return func(ctx context.Context) error {
+		sqldb, err := db.DB()
+		if err != nil {
+			return err
+		}
+		return sqldb.PingContext(ctx)
+	}
+}
+
+// Redis 检查 redis 连接
+func Redis(rd redis.UniversalClient) CheckFunc {
+	return func(ctx context.Context) error {
+		return rd.Ping(ctx).Err()
+	}
+}
+
+// TCP 任一地址可以建立连接即成功，用于 Kafka 等只需确认 broker 可达的依赖
+func TCP(addrs ...string) CheckFunc {
+	return func(ctx context.Context) error {
+		if len(addrs) == 0 {
+			return errors.New("no address")
+		}
+		var (
+			d    net.Dialer
+			errs []string
+		)
+		for _, addr := range addrs {
+			conn, err := d.DialContext(ctx, "tcp", addr)
+			if err == nil {
+				return conn.Close()
+			}
+			errs = append(errs, err.Error())
+		}
+		return errors.New(strings.Join(errs, "; "))
+	}
+}
+
+// Conn 检查下游 gRPC 连接，空闲时主动建立连接并等待就绪
+func Conn(cc *grpc.ClientConn) CheckFunc {
+	return func(ctx context.Context) error {
+		state := cc.GetState()
+		for {
+			switch state {
+			case connectivity.Ready:
+				return nil
+			case connectivity.Shutdown:
+				return errors.New("connection closed")
+			case connectivity.Idle:
+				cc.Connect()
+			}
+			if !cc.WaitForStateChange(ctx, state) {
+				return fmt.Errorf("connection %s: %w", strings.ToLower(state.String()), ctx.Err())
+			}
+			state = cc.GetState()
+		}
+	}
+}
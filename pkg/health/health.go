@@ -0,0 +1,229 @@
+/*
+ * 健康检查与就绪探针：
+ *
+ *	/healthz                      存活探针，进程能响应即返回 200
+ *	/readyz                       就绪探针，汇总 MySQL、Redis、Kafka、下游 gRPC 等依赖的检查结果
+ *	grpc.health.v1.Health/Check   kratos gRPC 服务内置的标准健康检查，依赖未就绪时返回 NOT_SERVING
+ *
+ * 依赖检查由 Server 在后台定时执行，连续失败达到阈值时从注册中心注销实例，恢复后重新注册
+ */
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// CheckFunc 依赖检查，返回 nil 表示依赖可用
+type CheckFunc func(ctx context.Context) error
+
+// Option 健康检查配置
+type Option func(*Health)
+
+// WithTimeout 单个依赖检查的超时时间，默认 1s
+func WithTimeout(d time.Duration) Option {
+	return func(h *Health) {
+		if d > 0 {
+			h.timeout = d
+		}
+	}
+}
+
+// WithInterval 后台检查间隔，默认 5s
+func WithInterval(d time.Duration) Option {
+	return func(h *Health) {
+		if d > 0 {
+			h.interval = d
+		}
+	}
+}
+
+// WithThreshold 连续失败多少轮后判定为未就绪，默认 3
+func WithThreshold(n int) Option {
+	return func(h *Health) {
+		if n > 0 {
+			h.threshold = n
+		}
+	}
+}
+
+// WithLogger 记录就绪状态的变化
+func WithLogger(logger log.Logger) Option {
+	return func(h *Health) {
+		h.log = log.NewHelper(log.With(logger, "module", "pkg/health"))
+	}
+}
+
+// Health 汇总各依赖的检查结果
+type Health struct {
+	timeout   time.Duration
+	interval  time.Duration
+	threshold int
+	log       *log.Helper
+
+	mu       sync.RWMutex
+	checks   map[string]CheckFunc
+	results  map[string]error
+	checked  bool
+	ready    bool
+	stopped  bool
+	failures int
+}
+
+// New 创建健康检查，首轮检查完成前未就绪
+func New(opts ...Option) *Health {
+	h := &Health{
+		timeout:   time.Second,
+		interval:  5 * time.Second,
+		threshold: 3,
+		log:       log.NewHelper(log.With(log.DefaultLogger, "module", "pkg/health")),
+		checks:    make(map[string]CheckFunc),
+		results:   make(map[string]error),
+	}
+	for _, o := range opts {
+		o(h)
+	}
+	return h
+}
+
+// Register 注册依赖检查，同名检查会被替换
+func (h *Health) Register(name string, fn CheckFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.checks[name] = fn
+}
+
+// Check 并发执行全部依赖检查并更新就绪状态，返回本轮各依赖的结果
+func (h *Health) Check(ctx context.Context) map[string]error {
+	h.mu.RLock()
+	checks := make(map[string]CheckFunc, len(h.checks))
+	for name, fn := range h.checks {
+		checks[name] = fn
+	}
+	h.mu.RUnlock()
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]error, len(checks))
+	)
+	for name, fn := range checks {
+		wg.Add(1)
+		go func(name string, fn CheckFunc) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, h.timeout)
+			defer cancel()
+			err := fn(ctx)
+			mu.Lock()
+			results[name] = err
+			mu.Unlock()
+		}(name, fn)
+	}
+	wg.Wait()
+
+	h.update(results)
+	return results
+}
+
+func (h *Health) update(results map[string]error) {
+	failed := false
+	for _, err := range results {
+		if err != nil {
+			failed = true
+			break
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.results = results
+	h.checked = true
+	if !failed {
+		if !h.ready {
+			h.log.Info("health: instance is ready")
+		}
+		h.failures, h.ready = 0, true
+		return
+	}
+	h.failures++
+	if h.ready && h.failures >= h.threshold {
+		h.ready = false
+		h.log.Warnf("health: instance is not ready after %d failed checks: %v", h.failures, failures(results))
+	}
+}
+
+// Ready 实例是否就绪：最近一次检查全部通过，或失败次数未达到阈值
+func (h *Health) Ready() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.ready && !h.stopped
+}
+
+func (h *Health) state() (ready, stopped bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.ready, h.stopped
+}
+
+func (h *Health) shutdown() {
+	h.mu.Lock()
+	h.stopped = true
+	h.mu.Unlock()
+}
+
+// Liveness 存活探针，挂载到 /healthz
+func (h *Health) Liveness(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok"))
+}
+
+// Readiness 就绪探针，挂载到 /readyz；返回最近一次检查的结果，未执行过检查时立即检查一次
+func (h *Health) Readiness(w http.ResponseWriter, r *http.Request) {
+	h.mu.RLock()
+	checked := h.checked
+	h.mu.RUnlock()
+	if !checked {
+		h.Check(r.Context())
+	}
+
+	h.mu.RLock()
+	res := readiness{Status: "ok", Checks: make(map[string]string, len(h.results))}
+	for name, err := range h.results {
+		if err != nil {
+			res.Checks[name] = err.Error()
+		} else {
+			res.Checks[name] = "ok"
+		}
+	}
+	ready := h.ready && !h.stopped
+	h.mu.RUnlock()
+
+	code := http.StatusOK
+	if !ready {
+		res.Status, code = "unavailable", http.StatusServiceUnavailable
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+type readiness struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+func failures(results map[string]error) map[string]string {
+	res := make(map[string]string)
+	for name, err := range results {
+		if err != nil {
+			res[name] = err.Error()
+		}
+	}
+	return res
+}
@@ -0,0 +1,160 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/registry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestReadiness(t *testing.T) {
+	var redisErr error
+	h := New(WithThreshold(2))
+	h.Register("mysql", func(ctx context.Context) error { return nil })
+	h.Register("redis", func(ctx context.Context) error { return redisErr })
+
+	readyz := func() (int, readiness) {
+		w := httptest.NewRecorder()
+		h.Readiness(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		var res readiness
+		if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+			t.Fatal(err)
+		}
+		return w.Code, res
+	}
+
+	if code, res := readyz(); code != http.StatusOK || res.Checks["redis"] != "ok" {
+		t.Fatalf("got %d %v, want ready", code, res)
+	}
+
+	// 未达到阈值前仍然就绪
+	redisErr = errors.New("connection refused")
+	h.Check(context.Background())
+	if code, res := readyz(); code != http.StatusOK || res.Checks["redis"] != "connection refused" {
+		t.Fatalf("got %d %v, want ready with failed redis", code, res)
+	}
+	h.Check(context.Background())
+	if code, res := readyz(); code != http.StatusServiceUnavailable || res.Status != "unavailable" {
+		t.Fatalf("got %d %v, want unavailable", code, res)
+	}
+
+	redisErr = nil
+	h.Check(context.Background())
+	if !h.Ready() {
+		t.Fatal("want ready after recovery")
+	}
+	h.shutdown()
+	if h.Ready() {
+		t.Fatal("want not ready after shutdown")
+	}
+}
+
+func TestCheckTimeout(t *testing.T) {
+	h := New()
+	h.timeout = 10 * time.Millisecond
+	h.Register("slow", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if err := h.Check(context.Background())["slow"]; !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want deadline exceeded", err)
+	}
+}
+
+func TestTCP(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	if err := TCP("127.0.0.1:1", lis.Addr().String())(context.Background()); err != nil {
+		t.Fatalf("got %v, want reachable", err)
+	}
+	if err := TCP()(context.Background()); err == nil {
+		t.Fatal("want error without address")
+	}
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	h := New()
+	h.Register("mysql", func(ctx context.Context) error { return errors.New("down") })
+	h.Check(context.Background())
+
+	serving := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVING}, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: healthCheckMethod}
+	reply, _ := h.UnaryInterceptor()(context.Background(), &grpc_health_v1.HealthCheckRequest{}, info, serving)
+	if s := reply.(*grpc_health_v1.HealthCheckResponse).Status; s != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("got %v, want NOT_SERVING", s)
+	}
+	info.FullMethod = "/api.user.service.v1.User/GetUser"
+	if reply, _ = h.UnaryInterceptor()(context.Background(), nil, info, serving); reply == nil {
+		t.Fatal("other methods should pass through")
+	}
+}
+
+type testRegistrar struct{ registered bool }
+
+func (r *testRegistrar) Register(ctx context.Context, ins *registry.ServiceInstance) error {
+	r.registered = true
+	return nil
+}
+
+func (r *testRegistrar) Deregister(ctx context.Context, ins *registry.ServiceInstance) error {
+	r.registered = false
+	return nil
+}
+
+type testApp struct{}
+
+func (testApp) ID() string                  { return "1" }
+func (testApp) Name() string                { return "casso.user.service" }
+func (testApp) Version() string             { return "v1" }
+func (testApp) Metadata() map[string]string { return nil }
+func (testApp) Endpoint() []string          { return []string{"grpc://127.0.0.1:9001"} }
+
+func TestDeregister(t *testing.T) {
+	var mysqlErr error
+	h := New(WithThreshold(1))
+	h.Register("mysql", func(ctx context.Context) error { return mysqlErr })
+	r := &testRegistrar{registered: true}
+	s := h.Server(r).(*server)
+	ctx := context.Background()
+
+	h.Check(ctx)
+	s.sync(ctx, testApp{})
+	if !r.registered {
+		t.Fatal("healthy instance should stay registered")
+	}
+
+	mysqlErr = errors.New("down")
+	h.Check(ctx)
+	s.sync(ctx, testApp{})
+	if r.registered {
+		t.Fatal("unhealthy instance should be deregistered")
+	}
+
+	mysqlErr = nil
+	h.Check(ctx)
+	s.sync(ctx, testApp{})
+	if !r.registered {
+		t.Fatal("recovered instance should be registered again")
+	}
+
+	h.shutdown()
+	mysqlErr = errors.New("down")
+	h.Check(ctx)
+	s.sync(ctx, testApp{})
+	if !r.registered {
+		t.Fatal("stopped instance is deregistered by kratos")
+	}
+}
@@ -0,0 +1,112 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const healthCheckMethod = "/grpc.health.v1.Health/Check"
+
+// UnaryInterceptor 依赖未就绪时 grpc.health.v1.Health/Check 返回 NOT_SERVING，
+// 就绪时交给 kratos 内置的健康检查服务（服务停止时同样返回 NOT_SERVING）
+func (h *Health) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if info.FullMethod == healthCheckMethod && !h.Ready() {
+			return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_NOT_SERVING}, nil
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Server 定时执行依赖检查的后台任务，作为 kratos.Server 随应用启停；
+// r 不为空时，未就绪的实例从注册中心注销，恢复后重新注册
+func (h *Health) Server(r registry.Registrar) transport.Server {
+	return &server{h: h, r: r, stop: make(chan struct{}), done: make(chan struct{})}
+}
+
+type server struct {
+	h            *Health
+	r            registry.Registrar
+	once         sync.Once
+	stop         chan struct{}
+	done         chan struct{}
+	deregistered *registry.ServiceInstance
+}
+
+func (s *server) Start(ctx context.Context) error {
+	defer close(s.done)
+	app, _ := kratos.FromContext(ctx)
+
+	ticker := time.NewTicker(s.h.interval)
+	defer ticker.Stop()
+	for {
+		s.h.Check(ctx)
+		s.sync(ctx, app)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *server) Stop(ctx context.Context) error {
+	s.h.shutdown()
+	s.once.Do(func() { close(s.stop) })
+	select {
+	case <-s.done:
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+// sync 按就绪状态注销或重新注册实例；应用注册完成前（Endpoint 为空）不处理，
+// 应用停止时由 kratos 注销，这里不再重新注册
+func (s *server) sync(ctx context.Context, app kratos.AppInfo) {
+	if s.r == nil || app == nil || ctx.Err() != nil {
+		return
+	}
+	ready, stopped := s.h.state()
+	if stopped {
+		return
+	}
+	switch {
+	case !ready && s.deregistered == nil:
+		ins := instance(app)
+		if len(ins.Endpoints) == 0 {
+			return
+		}
+		if err := s.r.Deregister(ctx, ins); err != nil {
+			s.h.log.Errorf("health: deregister unhealthy instance %s: %v", ins.ID, err)
+			return
+		}
+		s.deregistered = ins
+		s.h.log.Warnf("health: instance %s deregistered", ins.ID)
+	case ready && s.deregistered != nil:
+		if err := s.r.Register(ctx, s.deregistered); err != nil {
+			s.h.log.Errorf("health: register recovered instance %s: %v", s.deregistered.ID, err)
+			return
+		}
+		s.h.log.Infof("health: instance %s registered again", s.deregistered.ID)
+		s.deregistered = nil
+	}
+}
+
+func instance(app kratos.AppInfo) *registry.ServiceInstance {
+	return &registry.ServiceInstance{
+		ID:        app.ID(),
+		Name:      app.Name(),
+		Version:   app.Version(),
+		Metadata:  app.Metadata(),
+		Endpoints: app.Endpoint(),
+	}
+}
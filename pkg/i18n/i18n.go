@@ -0,0 +1,159 @@
+/*
+ * @PackageName: i18n
+ * @Description: 按错误 Reason 本地化错误提示
+ * 翻译文件位于 locales/{locale}.json，编译时嵌入二进制，key 为错误 Reason，
+ * 模板中的 {name} 使用错误 Metadata 中的同名字段替换：
+ *   "USER_NOT_FOUND": "用户 {mobile} 不存在"
+ *   errors.NotFound("USER_NOT_FOUND", "user not found").WithMetadata(map[string]string{"mobile": m})
+ */
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed locales/*.json
+var locales embed.FS
+
+const (
+	ZhCN = "zh-CN"
+	EnUS = "en-US"
+)
+
+// Catalog 翻译目录，加载后只读，可并发使用
+type Catalog struct {
+	fallback string
+	messages map[string]map[string]string
+}
+
+// Default 加载内置的翻译文件，默认语言为 zh-CN
+func Default() *Catalog {
+	c, err := Load(locales, "locales", ZhCN)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Load 加载 dir 下的 {locale}.json，fallback 为无法匹配时使用的语言
+func Load(fsys fs.FS, dir, fallback string) (*Catalog, error) {
+	files, err := fs.Glob(fsys, path.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	c := &Catalog{fallback: fallback, messages: make(map[string]map[string]string)}
+	for _, f := range files {
+		b, err := fs.ReadFile(fsys, f)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[string]string)
+		if err := json.Unmarshal(b, &m); err != nil {
+			return nil, fmt.Errorf("i18n: %s: %v", f, err)
+		}
+		c.messages[strings.TrimSuffix(path.Base(f), ".json")] = m
+	}
+	if _, ok := c.messages[fallback]; !ok {
+		return nil, fmt.Errorf("i18n: missing fallback locale %s", fallback)
+	}
+	return c, nil
+}
+
+// Locales 支持的语言
+func (c *Catalog) Locales() []string {
+	res := make([]string, 0, len(c.messages))
+	for l := range c.messages {
+		res = append(res, l)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// Message 返回 reason 在 locale 下的提示，找不到时依次尝试默认语言，都没有时返回 false
+func (c *Catalog) Message(locale, reason string, args map[string]string) (string, bool) {
+	tpl, ok := c.messages[locale][reason]
+	if !ok {
+		if tpl, ok = c.messages[c.fallback][reason]; !ok {
+			return "", false
+		}
+	}
+	return render(tpl, args), true
+}
+
+// render 替换模板中的 {name}，缺失的参数保持原样
+func render(tpl string, args map[string]string) string {
+	if len(args) == 0 || !strings.Contains(tpl, "{") {
+		return tpl
+	}
+	pairs := make([]string, 0, len(args)*2)
+	for k, v := range args {
+		pairs = append(pairs, "{"+k+"}", v)
+	}
+	return strings.NewReplacer(pairs...).Replace(tpl)
+}
+
+// Match 从 Accept-Language 中按 q 值选择支持的语言，如 "en-GB,en;q=0.9" 匹配 en-US；
+// 没有匹配时返回默认语言
+func (c *Catalog) Match(acceptLanguage string) string {
+	type tag struct {
+		lang string
+		q    float64
+	}
+	var tags []tag
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		lang := strings.TrimSpace(fields[0])
+		if lang == "" {
+			continue
+		}
+		q := 1.0
+		for _, p := range fields[1:] {
+			if v := strings.TrimSpace(p); strings.HasPrefix(v, "q=") {
+				if f, err := strconv.ParseFloat(v[2:], 64); err == nil {
+					q = f
+				}
+			}
+		}
+		if q > 0 {
+			tags = append(tags, tag{lang: lang, q: q})
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].q > tags[j].q
+	})
+	for _, t := range tags {
+		if l, ok := c.lookup(t.lang); ok {
+			return l
+		}
+	}
+	return c.fallback
+}
+
+// lookup 先精确匹配（大小写不敏感，支持 zh_CN），再按主语言匹配
+func (c *Catalog) lookup(lang string) (string, bool) {
+	lang = strings.ReplaceAll(lang, "_", "-")
+	for l := range c.messages {
+		if strings.EqualFold(l, lang) {
+			return l, true
+		}
+	}
+	primary := strings.ToLower(strings.SplitN(lang, "-", 2)[0])
+	var candidates []string
+	for l := range c.messages {
+		if strings.ToLower(strings.SplitN(l, "-", 2)[0]) == primary {
+			candidates = append(candidates, l)
+		}
+	}
+	if len(candidates) == 0 {
+		return "", false
+	}
+	sort.Strings(candidates)
+	return candidates[0], true
+}
@@ -0,0 +1,68 @@
+package i18n
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestBundlesHaveSameKeys(t *testing.T) {
+	c := Default()
+	zh, en := c.messages[ZhCN], c.messages[EnUS]
+	if len(zh) == 0 || len(zh) != len(en) {
+		t.Fatalf("zh-CN has %d messages, en-US has %d", len(zh), len(en))
+	}
+	for k := range zh {
+		if _, ok := en[k]; !ok {
+			t.Fatalf("en-US is missing %s", k)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	c := Default()
+	cases := map[string]string{
+		"":                        ZhCN,
+		"en-US":                   EnUS,
+		"en-GB,en;q=0.9":          EnUS,
+		"fr-FR, en;q=0.5":         EnUS,
+		"zh_cn":                   ZhCN,
+		"en;q=0.3, zh-TW;q=0.8":   ZhCN,
+		"de-DE":                   ZhCN,
+		"en-US;q=0, zh-CN;q=0.1 ": ZhCN,
+	}
+	for in, want := range cases {
+		if got := c.Match(in); got != want {
+			t.Fatalf("Match(%q) = %s, want %s", in, got, want)
+		}
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	c := Default()
+	err := errors.New(400, "SHOP_DUPLICATE_ENTRY", "duplicate entry").
+		WithMetadata(map[string]string{"field": "mobile"})
+
+	got := errors.FromError(c.Localize(EnUS, err))
+	if got.Message != "mobile already exists" || got.Reason != "SHOP_DUPLICATE_ENTRY" || got.Code != 400 {
+		t.Fatalf("got %v", got)
+	}
+
+	// 没有翻译时保持原样
+	raw := errors.New(500, "NO_SUCH_REASON", "raw message")
+	if got := errors.FromError(c.Localize(EnUS, raw)); got.Message != "raw message" {
+		t.Fatalf("got %v", got)
+	}
+}
+
+func TestServerMiddleware(t *testing.T) {
+	c := Default()
+	h := Server(c)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, errors.New(404, "USER_RECORD_NOT_FOUND", "not found")
+	})
+	_, err := h(NewContext(context.Background(), EnUS), nil)
+	if got := errors.FromError(err); got.Message != "User does not exist" {
+		t.Fatalf("got %v", got)
+	}
+}
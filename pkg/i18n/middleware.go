@@ -0,0 +1,70 @@
+package i18n
+
+import (
+	"casso/pkg/util/contextkey"
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/metadata"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// MetadataKey 服务间传递语言的 kratos 全局元数据
+var MetadataKey = contextkey.Locale.Header()
+
+// NewContext 将语言保存到 context
+func NewContext(ctx context.Context, locale string) context.Context {
+	return contextkey.Locale.With(ctx, locale)
+}
+
+// FromContext 获取 context 中的语言
+func FromContext(ctx context.Context) (string, bool) {
+	return contextkey.Locale.From(ctx)
+}
+
+// Resolve 解析请求语言：全局元数据 x-md-global-locale 优先，其次为 Accept-Language
+// （HTTP 请求头或 gRPC metadata），都没有时使用默认语言
+func (c *Catalog) Resolve(ctx context.Context) string {
+	if l, ok := FromContext(ctx); ok {
+		return l
+	}
+	if md, ok := metadata.FromServerContext(ctx); ok {
+		if l := md.Get(MetadataKey); l != "" {
+			return c.Match(l)
+		}
+	}
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		return c.Match(tr.RequestHeader().Get("Accept-Language"))
+	}
+	return c.fallback
+}
+
+// Localize 按语言翻译 kratos 错误的 Message，没有翻译时原样返回
+func (c *Catalog) Localize(locale string, err error) error {
+	if err == nil {
+		return nil
+	}
+	se := errors.FromError(err)
+	msg, ok := c.Message(locale, se.Reason, se.Metadata)
+	if !ok {
+		return err
+	}
+	res := errors.Clone(se)
+	res.Message = msg
+	return res.WithCause(err)
+}
+
+// Server 解析请求语言保存到 context，并翻译返回的错误
+func Server(c *Catalog) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			locale := c.Resolve(ctx)
+			reply, err := handler(NewContext(ctx, locale), req)
+			if err != nil {
+				return reply, c.Localize(locale, err)
+			}
+			return reply, nil
+		}
+	}
+}
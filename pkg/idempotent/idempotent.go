@@ -0,0 +1,149 @@
+/*
+ * @PackageName: idempotent
+ * @Description: 幂等处理，防止重复消息/重复请求被执行多次
+ * 以消息ID 或请求头 Idempotency-Key 作为幂等键：
+ *   1. 首次到达的请求原子占位（processing），执行业务
+ *   2. 业务成功后写入结果（done），在保留期内的重复请求直接返回首次结果
+ *   3. 业务失败则释放占位，允许调用方重试
+ *   4. 并发到达的重复请求在占位期间会得到 ErrInProgress
+ */
+package idempotent
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Status 幂等记录状态
+type Status int8
+
+const (
+	StatusProcessing Status = 1 // 处理中（占位）
+	StatusDone       Status = 2 // 已处理完成
+)
+
+var (
+	// ErrInProgress 相同幂等键的请求正在处理中
+	ErrInProgress = errors.New(409, "IDEMPOTENT_IN_PROGRESS", "request with the same idempotency key is in progress")
+	// ErrMissingKey 要求幂等键但请求未携带
+	ErrMissingKey = errors.New(400, "IDEMPOTENT_MISSING_KEY", "missing idempotency key")
+	// ErrLockLost 处理耗时超过占位有效期，占位已被回收
+	ErrLockLost = errors.New(409, "IDEMPOTENT_LOCK_LOST", "idempotency lock expired before completion")
+)
+
+// Record 幂等记录
+type Record struct {
+	Key      string
+	Status   Status
+	Token    string // 占位者标识，只有占位者才能完成/释放记录
+	Result   []byte // 首次执行的结果，用于重复请求回放
+	ExpireAt time.Time
+}
+
+// Store 幂等记录存储，实现需要保证 Acquire 的原子性
+type Store interface {
+	// Acquire 原子占位；acquired 为 false 时返回已存在的记录。
+	// 已过期（占位超时或超过保留期）的记录视为不存在，可被重新占位
+	Acquire(ctx context.Context, key, token string, lockTTL time.Duration) (rec *Record, acquired bool, err error)
+	// Complete 标记处理完成并保存结果，记录保留 retention 时长
+	Complete(ctx context.Context, key, token string, result []byte, retention time.Duration) error
+	// Release 处理失败时释放占位
+	Release(ctx context.Context, key, token string) error
+}
+
+// Option 幂等配置
+type Option func(*Guard)
+
+// WithLockTTL 处理中占位的有效期，超过后视为处理者已崩溃，允许重新占位
+func WithLockTTL(d time.Duration) Option {
+	return func(g *Guard) {
+		g.lockTTL = d
+	}
+}
+
+// WithRetention 处理结果的保留时长，保留期内的重复请求会被拦截
+func WithRetention(d time.Duration) Option {
+	return func(g *Guard) {
+		g.retention = d
+	}
+}
+
+// WithPrefix 幂等键前缀，用于区分不同业务
+func WithPrefix(prefix string) Option {
+	return func(g *Guard) {
+		g.prefix = prefix
+	}
+}
+
+// Guard 幂等守卫
+type Guard struct {
+	store     Store
+	lockTTL   time.Duration
+	retention time.Duration
+	prefix    string
+}
+
+// New 新建幂等守卫，默认占位 30s，结果保留 24h
+func New(store Store, opts ...Option) *Guard {
+	g := &Guard{
+		store:     store,
+		lockTTL:   30 * time.Second,
+		retention: 24 * time.Hour,
+		prefix:    "idempotent:",
+	}
+	for _, o := range opts {
+		o(g)
+	}
+	return g
+}
+
+// Do 以幂等方式执行 fn；replayed 为 true 表示本次为重复请求，result 为首次执行的结果
+func (g *Guard) Do(ctx context.Context, key string, fn func(ctx context.Context) ([]byte, error)) (result []byte, replayed bool, err error) {
+	if key == "" {
+		return nil, false, ErrMissingKey
+	}
+	key = g.prefix + key
+	token := newToken()
+
+	rec, acquired, err := g.store.Acquire(ctx, key, token, g.lockTTL)
+	if err != nil {
+		return nil, false, err
+	}
+	if !acquired {
+		if rec.Status == StatusDone {
+			return rec.Result, true, nil
+		}
+		return nil, false, ErrInProgress
+	}
+
+	defer func() {
+		// 业务 panic 时同样释放占位，panic 继续向上抛给 recovery 中间件
+		if r := recover(); r != nil {
+			_ = g.store.Release(context.Background(), key, token)
+			panic(r)
+		}
+	}()
+
+	result, err = fn(ctx)
+	if err != nil {
+		// 释放失败时占位会在 lockTTL 后自动过期，这里以业务错误为准
+		_ = g.store.Release(ctx, key, token)
+		return nil, false, err
+	}
+	// 占位已过期说明处理太慢，业务已执行成功，此时只能放弃保存结果
+	if err = g.store.Complete(ctx, key, token, result, g.retention); err != nil && !errors.Is(err, ErrLockLost) {
+		return nil, false, err
+	}
+	return result, false, nil
+}
+
+// newToken 随机生成占位标识
+func newToken() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
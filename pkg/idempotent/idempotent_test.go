@@ -0,0 +1,179 @@
+package idempotent
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"casso/pkg/util/contextkey"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestGuardDo(t *testing.T) {
+	g := New(NewMemoryStore())
+	ctx := context.Background()
+
+	var calls int32
+	fn := func(ctx context.Context) ([]byte, error) {
+		atomic.AddInt32(&calls, 1)
+		return []byte("ok"), nil
+	}
+
+	res, replayed, err := g.Do(ctx, "order-1", fn)
+	if err != nil || replayed || string(res) != "ok" {
+		t.Fatalf("first call: res=%s replayed=%v err=%v", res, replayed, err)
+	}
+	res, replayed, err = g.Do(ctx, "order-1", fn)
+	if err != nil || !replayed || string(res) != "ok" {
+		t.Fatalf("duplicate call: res=%s replayed=%v err=%v", res, replayed, err)
+	}
+	if calls != 1 {
+		t.Fatalf("handler executed %d times, want 1", calls)
+	}
+}
+
+func TestGuardReleaseOnError(t *testing.T) {
+	g := New(NewMemoryStore())
+	ctx := context.Background()
+
+	bizErr := errors.New("biz failed")
+	if _, _, err := g.Do(ctx, "order-2", func(ctx context.Context) ([]byte, error) {
+		return nil, bizErr
+	}); err != bizErr {
+		t.Fatalf("want biz error, got %v", err)
+	}
+	// 失败后允许重试
+	if _, replayed, err := g.Do(ctx, "order-2", func(ctx context.Context) ([]byte, error) {
+		return nil, nil
+	}); err != nil || replayed {
+		t.Fatalf("retry after failure: replayed=%v err=%v", replayed, err)
+	}
+}
+
+func TestGuardConcurrent(t *testing.T) {
+	g := New(NewMemoryStore())
+	ctx := context.Background()
+
+	var (
+		calls      int32
+		inProgress int32
+		wg         sync.WaitGroup
+		start      = make(chan struct{})
+	)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, err := g.Do(ctx, "order-3", func(ctx context.Context) ([]byte, error) {
+				atomic.AddInt32(&calls, 1)
+				<-start
+				return nil, nil
+			})
+			if errors.Is(err, ErrInProgress) {
+				atomic.AddInt32(&inProgress, 1)
+			}
+		}()
+	}
+	// 等待其余请求全部被拦截后再放行首个请求
+	for atomic.LoadInt32(&inProgress) < 19 {
+		runtime.Gosched()
+	}
+	close(start)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("handler executed %d times, want 1", calls)
+	}
+}
+
+type headerCarrier map[string]string
+
+func (h headerCarrier) Get(key string) string { return h[key] }
+func (h headerCarrier) Set(key, value string) { h[key] = value }
+func (h headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+type testTransport struct {
+	header headerCarrier
+}
+
+func (tr *testTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (tr *testTransport) Endpoint() string                { return "" }
+func (tr *testTransport) Operation() string               { return "/api.shop.service.v1.Shop/Register" }
+func (tr *testTransport) RequestHeader() transport.Header { return tr.header }
+func (tr *testTransport) ReplyHeader() transport.Header   { return headerCarrier{} }
+
+func TestServerReplay(t *testing.T) {
+	var calls int
+	h := Server(New(NewMemoryStore()))(func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return wrapperspb.String("registered"), nil
+	})
+	ctx := transport.NewServerContext(context.Background(), &testTransport{header: headerCarrier{HeaderKey: "k1"}})
+
+	for i := 0; i < 2; i++ {
+		reply, err := h(ctx, wrapperspb.String("13800000000"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(reply.(proto.Message), wrapperspb.String("registered")) {
+			t.Fatalf("unexpected reply: %v", reply)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("handler executed %d times, want 1", calls)
+	}
+}
+
+func TestServerKeyReuse(t *testing.T) {
+	var calls int
+	h := Server(New(NewMemoryStore()))(func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return req, nil
+	})
+	ctx := transport.NewServerContext(context.Background(), &testTransport{header: headerCarrier{HeaderKey: "k1"}})
+	if _, err := h(ctx, wrapperspb.String("13800000000")); err != nil {
+		t.Fatal(err)
+	}
+	// 相同幂等键、不同请求体时拒绝，而不是回放首次的响应
+	if _, err := h(ctx, wrapperspb.String("13900000000")); !errors.Is(err, ErrKeyReused) {
+		t.Fatalf("want ErrKeyReused, got %v", err)
+	}
+	// 不同用户的相同幂等键互不影响
+	reply, err := h(contextkey.UserID.With(ctx, 2), wrapperspb.String("13900000000"))
+	if err != nil || !proto.Equal(reply.(proto.Message), wrapperspb.String("13900000000")) {
+		t.Fatalf("other user: reply=%v err=%v", reply, err)
+	}
+	if calls != 2 {
+		t.Fatalf("handler executed %d times, want 2", calls)
+	}
+}
+
+func TestServerUnsupportedReply(t *testing.T) {
+	var calls int
+	h := Server(New(NewMemoryStore()))(func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return "not a proto message", nil
+	})
+	ctx := transport.NewServerContext(context.Background(), &testTransport{header: headerCarrier{HeaderKey: "k1"}})
+	for i := 0; i < 2; i++ {
+		if _, err := h(ctx, wrapperspb.String("13800000000")); !errors.Is(err, ErrUnsupportedReply) {
+			t.Fatalf("want ErrUnsupportedReply, got %v", err)
+		}
+	}
+	// 没有保存空结果，重试会再次执行
+	if calls != 2 {
+		t.Fatalf("handler executed %d times, want 2", calls)
+	}
+}
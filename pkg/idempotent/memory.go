@@ -0,0 +1,59 @@
+package idempotent
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+var _ Store = (*MemoryStore)(nil)
+
+// MemoryStore 进程内幂等存储，仅用于单实例部署与测试
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]*Record
+}
+
+// NewMemoryStore 新建进程内幂等存储
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: make(map[string]*Record)}
+}
+
+func (s *MemoryStore) Acquire(ctx context.Context, key, token string, lockTTL time.Duration) (*Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if rec, ok := s.records[key]; ok && rec.ExpireAt.After(now) {
+		cp := *rec
+		return &cp, false, nil
+	}
+	rec := &Record{Key: key, Status: StatusProcessing, Token: token, ExpireAt: now.Add(lockTTL)}
+	s.records[key] = rec
+	cp := *rec
+	return &cp, true, nil
+}
+
+func (s *MemoryStore) Complete(ctx context.Context, key, token string, result []byte, retention time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, ok := s.records[key]
+	if !ok || rec.Token != token {
+		return ErrLockLost
+	}
+	rec.Status = StatusDone
+	rec.Result = result
+	rec.ExpireAt = time.Now().Add(retention)
+	return nil
+}
+
+func (s *MemoryStore) Release(ctx context.Context, key, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if rec, ok := s.records[key]; ok && rec.Token == token {
+		delete(s.records, key)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package idempotent
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"strconv"
+
+	"casso/pkg/util/contextkey"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// HeaderKey 默认的幂等请求头
+const HeaderKey = "Idempotency-Key"
+
+// keyGetter 请求体自带幂等键时优先使用，例如支付回调的流水号
+type keyGetter interface {
+	GetIdempotencyKey() string
+}
+
+var (
+	// ErrKeyReused 相同幂等键的请求内容与首次不一致
+	ErrKeyReused = errors.New(422, "IDEMPOTENT_KEY_REUSED", "idempotency key was used with a different request")
+	// ErrUnsupportedReply 响应不是 proto.Message，无法保存与回放
+	ErrUnsupportedReply = errors.New(500, "IDEMPOTENT_UNSUPPORTED_REPLY", "idempotent reply must be a proto message")
+)
+
+type serverOptions struct {
+	header   string
+	required bool
+	scope    func(ctx context.Context) string
+}
+
+// ServerOption 幂等中间件配置
+type ServerOption func(*serverOptions)
+
+// WithHeader 自定义幂等请求头，http 与 grpc metadata 均从此键读取
+func WithHeader(header string) ServerOption {
+	return func(o *serverOptions) {
+		o.header = header
+	}
+}
+
+// WithRequired 未携带幂等键的请求直接拒绝，默认放行
+func WithRequired() ServerOption {
+	return func(o *serverOptions) {
+		o.required = true
+	}
+}
+
+// WithScope 按调用方隔离幂等键，返回空字符串时只按 operation 隔离；默认使用 contextkey.UserID
+func WithScope(scope func(ctx context.Context) string) ServerOption {
+	return func(o *serverOptions) {
+		o.scope = scope
+	}
+}
+
+func userScope(ctx context.Context) string {
+	if uid, ok := contextkey.UserID.From(ctx); ok {
+		return strconv.FormatInt(uid, 10)
+	}
+	return ""
+}
+
+// Server kratos http/grpc 服务端幂等中间件。
+// 幂等键按 operation 与调用方隔离，重复请求直接回放首次的响应；请求体与首次不一致时返回 ErrKeyReused。
+// 请求与响应需为 proto.Message，请求不是 proto.Message 时不做幂等处理
+func Server(g *Guard, opts ...ServerOption) middleware.Middleware {
+	o := &serverOptions{header: HeaderKey, scope: userScope}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			key := tr.RequestHeader().Get(o.header)
+			if v, ok := req.(keyGetter); ok && v.GetIdempotencyKey() != "" {
+				key = v.GetIdempotencyKey()
+			}
+			if key == "" {
+				if o.required {
+					return nil, ErrMissingKey
+				}
+				return handler(ctx, req)
+			}
+			sum, ok := fingerprint(req)
+			if !ok {
+				return handler(ctx, req)
+			}
+			if scope := o.scope(ctx); scope != "" {
+				key = scope + ":" + key
+			}
+
+			var reply interface{}
+			result, replayed, err := g.Do(ctx, tr.Operation()+":"+key, func(ctx context.Context) ([]byte, error) {
+				var err error
+				reply, err = handler(ctx, req)
+				if err != nil {
+					return nil, err
+				}
+				data, err := marshalReply(reply)
+				if err != nil {
+					return nil, err
+				}
+				// 请求体摘要保存在结果前面，回放前校验
+				return append(sum, data...), nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			if replayed {
+				if len(result) < sha256.Size || !bytes.Equal(result[:sha256.Size], sum) {
+					return nil, ErrKeyReused
+				}
+				return unmarshalReply(result[sha256.Size:])
+			}
+			return reply, nil
+		}
+	}
+}
+
+// fingerprint 请求体的 sha256，相同内容的请求序列化结果一致
+func fingerprint(req interface{}) ([]byte, bool) {
+	msg, ok := req.(proto.Message)
+	if !ok || msg == nil {
+		return nil, false
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return nil, false
+	}
+	sum := sha256.Sum256(data)
+	return sum[:], true
+}
+
+// marshalReply 以 Any 的形式保存响应，回放时无需知道具体类型。
+// 响应不是 proto.Message 时返回错误并释放占位，不会把空响应当作首次结果回放
+func marshalReply(reply interface{}) ([]byte, error) {
+	msg, ok := reply.(proto.Message)
+	if !ok || msg == nil {
+		return nil, ErrUnsupportedReply
+	}
+	a, err := anypb.New(msg)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(a)
+}
+
+func unmarshalReply(data []byte) (interface{}, error) {
+	var a anypb.Any
+	if err := proto.Unmarshal(data, &a); err != nil {
+		return nil, err
+	}
+	return a.UnmarshalNew()
+}
+
+// ConsumerHandler 消息处理函数
+type ConsumerHandler func(ctx context.Context, msgID string, payload []byte) error
+
+// Consumer 消息消费幂等中间件，重复投递的消息直接确认而不再执行业务
+func Consumer(g *Guard) func(ConsumerHandler) ConsumerHandler {
+	return func(next ConsumerHandler) ConsumerHandler {
+		return func(ctx context.Context, msgID string, payload []byte) error {
+			_, _, err := g.Do(ctx, msgID, func(ctx context.Context) ([]byte, error) {
+				return nil, next(ctx, msgID, payload)
+			})
+			return err
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package idempotent
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ Store = (*MySQLStore)(nil)
+
+var (
+	MySQLRecordTableName = "idempotent_record"
+)
+
+// MySQLRecord 幂等记录表，依赖主键唯一约束保证并发占位的原子性
+type MySQLRecord struct {
+	Key    string `gorm:"column:idem_key;primarykey;type:varchar(191);COMMENT:幂等键"`
+	Status int8   `gorm:"type:tinyint;COMMENT:状态 1处理中 2已完成"`
+	Token  string `gorm:"type:varchar(32);COMMENT:占位标识"`
+	Result []byte `gorm:"type:blob;COMMENT:处理结果"`
+
+	ExpireTime  int64 `gorm:"type:bigint(20);index;COMMENT:过期时间(毫秒)"`
+	CreatedTime int64 `gorm:"type:bigint(20);COMMENT:创建时间"`
+}
+
+func (r *MySQLRecord) TableName() string {
+	return MySQLRecordTableName
+}
+
+// MySQLStore 基于 mysql 的幂等存储；过期记录需要定期调用 Purge 清理
+type MySQLStore struct {
+	db *gorm.DB
+}
+
+// NewMySQLStore 新建 mysql 幂等存储
+func NewMySQLStore(db *gorm.DB) *MySQLStore {
+	return &MySQLStore{db: db}
+}
+
+func (s *MySQLStore) Acquire(ctx context.Context, key, token string, lockTTL time.Duration) (*Record, bool, error) {
+	db := s.db.WithContext(ctx)
+	// 记录可能在两次查询之间被释放/清理，有限次重试
+	for i := 0; i < 3; i++ {
+		now := time.Now()
+		expire := now.Add(lockTTL)
+
+		// 1. 插入占位，主键冲突则什么都不做
+		res := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&MySQLRecord{
+			Key:         key,
+			Status:      int8(StatusProcessing),
+			Token:       token,
+			ExpireTime:  toMilli(expire),
+			CreatedTime: now.Unix(),
+		})
+		if res.Error != nil {
+			return nil, false, res.Error
+		}
+		if res.RowsAffected == 1 {
+			return &Record{Key: key, Status: StatusProcessing, Token: token, ExpireAt: expire}, true, nil
+		}
+
+		// 2. 已存在但过期，抢占
+		res = db.Model(&MySQLRecord{}).
+			Where("idem_key = ? AND expire_time < ?", key, toMilli(now)).
+			Updates(map[string]interface{}{
+				"status":      int8(StatusProcessing),
+				"token":       token,
+				"result":      nil,
+				"expire_time": toMilli(expire),
+			})
+		if res.Error != nil {
+			return nil, false, res.Error
+		}
+		if res.RowsAffected == 1 {
+			return &Record{Key: key, Status: StatusProcessing, Token: token, ExpireAt: expire}, true, nil
+		}
+
+		// 3. 未过期，返回已有记录
+		var r MySQLRecord
+		err := db.Where("idem_key = ?", key).Take(&r).Error
+		if err == gorm.ErrRecordNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		return &Record{
+			Key:      r.Key,
+			Status:   Status(r.Status),
+			Token:    r.Token,
+			Result:   r.Result,
+			ExpireAt: time.Unix(0, r.ExpireTime*int64(time.Millisecond)),
+		}, false, nil
+	}
+	return nil, false, ErrInProgress
+}
+
+func (s *MySQLStore) Complete(ctx context.Context, key, token string, result []byte, retention time.Duration) error {
+	res := s.db.WithContext(ctx).Model(&MySQLRecord{}).
+		Where("idem_key = ? AND token = ?", key, token).
+		Updates(map[string]interface{}{
+			"status":      int8(StatusDone),
+			"result":      result,
+			"expire_time": toMilli(time.Now().Add(retention)),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrLockLost
+	}
+	return nil
+}
+
+func (s *MySQLStore) Release(ctx context.Context, key, token string) error {
+	return s.db.WithContext(ctx).
+		Where("idem_key = ? AND token = ?", key, token).
+		Delete(&MySQLRecord{}).Error
+}
+
+// Purge 清理已过期的记录，limit 为单次删除上限，返回删除条数
+func (s *MySQLStore) Purge(ctx context.Context, limit int) (int64, error) {
+	res := s.db.WithContext(ctx).
+		Where("expire_time < ?", toMilli(time.Now())).
+		Limit(limit).
+		Delete(&MySQLRecord{})
+	return res.RowsAffected, res.Error
+}
+
+func toMilli(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
@@ -0,0 +1,90 @@
+package idempotent
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+var _ Store = (*RedisStore)(nil)
+
+// 记录使用 hash 存储: s 状态, t 占位标识, r 处理结果；过期交给 redis TTL
+var (
+	// 不存在则占位，存在则返回已有记录
+	acquireScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return redis.call('HMGET', KEYS[1], 's', 't', 'r')
+end
+redis.call('HSET', KEYS[1], 's', ARGV[1], 't', ARGV[2])
+redis.call('PEXPIRE', KEYS[1], ARGV[3])
+return false
+`)
+	// 仅占位者可以写入结果
+	completeScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 't') ~= ARGV[1] then
+	return 0
+end
+redis.call('HSET', KEYS[1], 's', ARGV[2], 'r', ARGV[3])
+redis.call('PEXPIRE', KEYS[1], ARGV[4])
+return 1
+`)
+	// 仅占位者可以释放
+	releaseScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 't') == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+)
+
+// RedisStore 基于 redis 的幂等存储
+type RedisStore struct {
+	rd redis.UniversalClient
+}
+
+// NewRedisStore 新建 redis 幂等存储
+func NewRedisStore(rd redis.UniversalClient) *RedisStore {
+	return &RedisStore{rd: rd}
+}
+
+func (s *RedisStore) Acquire(ctx context.Context, key, token string, lockTTL time.Duration) (*Record, bool, error) {
+	res, err := acquireScript.Run(ctx, s.rd, []string{key}, int(StatusProcessing), token, lockTTL.Milliseconds()).Result()
+	if err == redis.Nil {
+		return &Record{Key: key, Status: StatusProcessing, Token: token, ExpireAt: time.Now().Add(lockTTL)}, true, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	rec := &Record{Key: key}
+	if vals, ok := res.([]interface{}); ok && len(vals) == 3 {
+		if v, ok := vals[0].(string); ok && v == "2" {
+			rec.Status = StatusDone
+		} else {
+			rec.Status = StatusProcessing
+		}
+		if v, ok := vals[1].(string); ok {
+			rec.Token = v
+		}
+		if v, ok := vals[2].(string); ok {
+			rec.Result = []byte(v)
+		}
+	}
+	return rec, false, nil
+}
+
+func (s *RedisStore) Complete(ctx context.Context, key, token string, result []byte, retention time.Duration) error {
+	n, err := completeScript.Run(ctx, s.rd, []string{key}, token, int(StatusDone), result, retention.Milliseconds()).Int()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrLockLost
+	}
+	return nil
+}
+
+func (s *RedisStore) Release(ctx context.Context, key, token string) error {
+	return releaseScript.Run(ctx, s.rd, []string{key}, token).Err()
+}
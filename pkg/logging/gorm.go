@@ -0,0 +1,79 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// DefaultSlowThreshold 未配置慢查询阈值时使用
+const DefaultSlowThreshold = 200 * time.Millisecond
+
+type gormLogger struct {
+	logger log.Logger
+	slow   time.Duration
+	level  gormlogger.LogLevel
+}
+
+// GORM 把 GORM 的日志转发到 kratos log.Logger：默认只输出执行失败（不含 ErrRecordNotFound）与超过 slow 的 SQL，
+// db.Debug() 时以 debug 级别输出全部 SQL；日志带有 ctx 中的 trace_id、span_id
+func GORM(logger log.Logger, slow time.Duration) gormlogger.Interface {
+	if slow <= 0 {
+		slow = DefaultSlowThreshold
+	}
+	return &gormLogger{logger: logger, slow: slow, level: gormlogger.Warn}
+}
+
+func (l *gormLogger) LogMode(level gormlogger.LogLevel) gormlogger.Interface {
+	nl := *l
+	nl.level = level
+	return &nl
+}
+
+func (l *gormLogger) Info(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= gormlogger.Info {
+		l.log(ctx, log.LevelInfo, "msg", fmt.Sprintf(msg, data...))
+	}
+}
+
+func (l *gormLogger) Warn(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= gormlogger.Warn {
+		l.log(ctx, log.LevelWarn, "msg", fmt.Sprintf(msg, data...))
+	}
+}
+
+func (l *gormLogger) Error(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= gormlogger.Error {
+		l.log(ctx, log.LevelError, "msg", fmt.Sprintf(msg, data...))
+	}
+}
+
+func (l *gormLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	if l.level <= gormlogger.Silent {
+		return
+	}
+	elapsed := time.Since(begin)
+	switch {
+	case err != nil && l.level >= gormlogger.Error && !errors.Is(err, gorm.ErrRecordNotFound):
+		sql, rows := fc()
+		l.log(ctx, log.LevelError, "msg", "sql error", "error", err.Error(), "sql", sql, "rows", rows, "latency", elapsed.Seconds())
+	case elapsed > l.slow && l.level >= gormlogger.Warn:
+		sql, rows := fc()
+		l.log(ctx, log.LevelWarn, "msg", "slow sql", "threshold", l.slow.String(), "sql", sql, "rows", rows, "latency", elapsed.Seconds())
+	case l.level >= gormlogger.Info:
+		sql, rows := fc()
+		l.log(ctx, log.LevelDebug, "msg", "sql", "sql", sql, "rows", rows, "latency", elapsed.Seconds())
+	}
+}
+
+func (l *gormLogger) log(ctx context.Context, level log.Level, keyvals ...interface{}) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	_ = log.WithContext(ctx, l.logger).Log(level, keyvals...)
+}
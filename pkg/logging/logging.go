@@ -0,0 +1,182 @@
+/*
+ * 日志：zap 实现的 kratos log.Logger，默认输出 JSON，级别可以通过 SetLevel 热更新；
+ * 可选按 msg 限流采样与 lumberjack 文件切割
+ *
+ * With 添加服务名、版本与 ctx 中的 trace_id、span_id，没有链路时不输出这两个字段；
+ * GORM 的日志通过 GORM 转发到 kratos log.Logger
+ */
+package logging
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// 输出格式
+const (
+	FormatJSON    = "json" // 默认
+	FormatConsole = "console"
+)
+
+var _ log.Logger = (*Logger)(nil)
+
+// Logger zap 实现的 kratos log.Logger
+type Logger struct {
+	core  zapcore.Core
+	level zap.AtomicLevel
+	out   io.Writer
+}
+
+// New 按配置创建 Logger，退出前调用 Close 刷新缓冲并关闭日志文件
+func New(c *Config) (*Logger, error) {
+	level := zap.NewAtomicLevel()
+	if c.GetLevel() != "" {
+		if err := level.UnmarshalText([]byte(c.GetLevel())); err != nil {
+			return nil, fmt.Errorf("logging: invalid level %q: %w", c.GetLevel(), err)
+		}
+	}
+
+	encCfg := zap.NewProductionEncoderConfig()
+	encCfg.EncodeTime = zapcore.ISO8601TimeEncoder
+	var enc zapcore.Encoder
+	switch c.GetFormat() {
+	case "", FormatJSON:
+		enc = zapcore.NewJSONEncoder(encCfg)
+	case FormatConsole:
+		encCfg.EncodeLevel = zapcore.CapitalLevelEncoder
+		enc = zapcore.NewConsoleEncoder(encCfg)
+	default:
+		return nil, fmt.Errorf("logging: unknown format %q", c.GetFormat())
+	}
+
+	var out io.Writer = os.Stdout
+	if f := c.GetFile(); f.GetPath() != "" {
+		maxSize := int(f.GetMaxSize())
+		if maxSize <= 0 {
+			maxSize = 100
+		}
+		out = &lumberjack.Logger{
+			Filename:   f.GetPath(),
+			MaxSize:    maxSize,
+			MaxBackups: int(f.GetMaxBackups()),
+			MaxAge:     int(f.GetMaxAge()),
+			Compress:   f.GetCompress(),
+			LocalTime:  true,
+		}
+	}
+
+	core := zapcore.NewCore(enc, zapcore.AddSync(out), level)
+	if s := c.GetSampling(); s != nil {
+		initial, tick := int(s.GetInitial()), time.Second
+		if initial <= 0 {
+			initial = 100
+		}
+		if s.GetTick() != nil {
+			tick = s.GetTick().AsDuration()
+		}
+		core = zapcore.NewSamplerWithOptions(core, tick, initial, int(s.GetThereafter()))
+	}
+	return &Logger{core: core, level: level, out: out}, nil
+}
+
+// Log 实现 kratos log.Logger；msg 作为 zap 的 message，其余键值作为字段
+func (l *Logger) Log(level log.Level, keyvals ...interface{}) error {
+	if len(keyvals)%2 != 0 {
+		keyvals = append(keyvals, "KEYVALS UNPAIRED")
+	}
+	var (
+		msg    string
+		fields = make([]zap.Field, 0, len(keyvals)/2)
+	)
+	for i := 0; i < len(keyvals); i += 2 {
+		key := fmt.Sprint(keyvals[i])
+		switch key {
+		case log.DefaultMessageKey:
+			msg = fmt.Sprint(keyvals[i+1])
+			continue
+		case "trace_id", "span_id":
+			if v, ok := keyvals[i+1].(string); ok && v == "" {
+				continue
+			}
+		}
+		fields = append(fields, zap.Any(key, keyvals[i+1]))
+	}
+
+	// 直接写 core，Fatal 由 kratos log.Helper 退出进程
+	ent := zapcore.Entry{Level: zapLevel(level), Time: time.Now(), Message: msg}
+	if ce := l.core.Check(ent, nil); ce != nil {
+		ce.Write(fields...)
+	}
+	return nil
+}
+
+// SetLevel 修改日志级别，立即对全部日志生效
+func (l *Logger) SetLevel(level string) error {
+	return l.level.UnmarshalText([]byte(level))
+}
+
+// Level 当前日志级别
+func (l *Logger) Level() string {
+	return l.level.Level().String()
+}
+
+// Validate 校验级别与输出格式，由 pkg/config 在加载、重新加载时调用
+func (x *Config) Validate() error {
+	if x.GetLevel() != "" {
+		var level zapcore.Level
+		if err := level.UnmarshalText([]byte(x.GetLevel())); err != nil {
+			return fmt.Errorf("logging: invalid level %q", x.GetLevel())
+		}
+	}
+	switch x.GetFormat() {
+	case "", FormatJSON, FormatConsole:
+	default:
+		return fmt.Errorf("logging: unknown format %q", x.GetFormat())
+	}
+	return nil
+}
+
+// Close 刷新缓冲，输出到文件时关闭文件
+func (l *Logger) Close() error {
+	err := l.core.Sync()
+	if c, ok := l.out.(io.Closer); ok && l.out != os.Stdout {
+		if cerr := c.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+func zapLevel(level log.Level) zapcore.Level {
+	switch level {
+	case log.LevelDebug:
+		return zapcore.DebugLevel
+	case log.LevelWarn:
+		return zapcore.WarnLevel
+	case log.LevelError:
+		return zapcore.ErrorLevel
+	case log.LevelFatal:
+		return zapcore.FatalLevel
+	default:
+		return zapcore.InfoLevel
+	}
+}
+
+// With 服务日志的公共字段：服务名、版本、调用位置，以及 ctx 中的 trace_id、span_id
+func With(logger log.Logger, name, version string) log.Logger {
+	return log.With(logger,
+		"service.name", name,
+		"service.version", version,
+		"caller", log.DefaultCaller,
+		"trace_id", tracing.TraceID(),
+		"span_id", tracing.SpanID(),
+	)
+}
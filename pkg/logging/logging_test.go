@@ -0,0 +1,129 @@
+package logging
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+func newFileLogger(t *testing.T, c *Config) (*Logger, func() []map[string]interface{}) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "app.log")
+	c.File = &Config_File{Path: path}
+	l, err := New(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l, func() []map[string]interface{} {
+		if err := l.Close(); err != nil {
+			t.Fatal(err)
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		var lines []map[string]interface{}
+		s := bufio.NewScanner(f)
+		for s.Scan() {
+			var m map[string]interface{}
+			if err := json.Unmarshal(s.Bytes(), &m); err != nil {
+				t.Fatalf("%s: %v", s.Text(), err)
+			}
+			lines = append(lines, m)
+		}
+		return lines
+	}
+}
+
+func TestLogger(t *testing.T) {
+	l, read := newFileLogger(t, &Config{Level: "info"})
+	_ = l.Log(log.LevelDebug, "msg", "dropped")
+	_ = l.Log(log.LevelInfo, "msg", "hello", "user_id", 1, "trace_id", "", "span_id", "")
+	if err := l.SetLevel("debug"); err != nil {
+		t.Fatal(err)
+	}
+	_ = l.Log(log.LevelDebug, "msg", "kept", "trace_id", "abc")
+	if err := l.SetLevel("verbose"); err == nil {
+		t.Error("want error for invalid level")
+	}
+	if l.Level() != "debug" {
+		t.Errorf("level = %s", l.Level())
+	}
+
+	lines := read()
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines: %v", len(lines), lines)
+	}
+	if lines[0]["msg"] != "hello" || lines[0]["level"] != "info" || lines[0]["user_id"] != float64(1) {
+		t.Errorf("line = %v", lines[0])
+	}
+	if _, ok := lines[0]["trace_id"]; ok {
+		t.Error("empty trace_id should be omitted")
+	}
+	if lines[1]["msg"] != "kept" || lines[1]["trace_id"] != "abc" {
+		t.Errorf("line = %v", lines[1])
+	}
+}
+
+func TestSampling(t *testing.T) {
+	l, read := newFileLogger(t, &Config{Sampling: &Config_Sampling{Initial: 3, Thereafter: 0, Tick: durationpb.New(time.Minute)}})
+	for i := 0; i < 10; i++ {
+		_ = l.Log(log.LevelInfo, "msg", "noisy")
+	}
+	_ = l.Log(log.LevelInfo, "msg", "other")
+	if got := len(read()); got != 4 {
+		t.Errorf("got %d lines, want 4", got)
+	}
+}
+
+type recorder struct {
+	levels []log.Level
+	msgs   []string
+}
+
+func (r *recorder) Log(level log.Level, keyvals ...interface{}) error {
+	r.levels = append(r.levels, level)
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		if keyvals[i] == "msg" {
+			r.msgs = append(r.msgs, keyvals[i+1].(string))
+		}
+	}
+	return nil
+}
+
+func TestGORM(t *testing.T) {
+	r := &recorder{}
+	gl := GORM(r, 50*time.Millisecond)
+	ctx := context.Background()
+	fc := func() (string, int64) { return "SELECT 1", 1 }
+
+	gl.Trace(ctx, time.Now(), fc, nil)
+	gl.Trace(ctx, time.Now(), fc, gorm.ErrRecordNotFound)
+	gl.Trace(ctx, time.Now().Add(-time.Second), fc, nil)
+	gl.Trace(ctx, time.Now(), fc, errors.New("deadlock"))
+	gl.LogMode(gormlogger.Silent).Trace(ctx, time.Now(), fc, errors.New("silent"))
+
+	want := []string{"slow sql", "sql error"}
+	if len(r.msgs) != len(want) {
+		t.Fatalf("got %v", r.msgs)
+	}
+	for i := range want {
+		if r.msgs[i] != want[i] {
+			t.Errorf("msgs[%d] = %s, want %s", i, r.msgs[i], want[i])
+		}
+	}
+	if r.levels[0] != log.LevelWarn || r.levels[1] != log.LevelError {
+		t.Errorf("levels = %v", r.levels)
+	}
+}
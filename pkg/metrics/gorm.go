@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"gorm.io/gorm"
+)
+
+const gormStartKey = "metrics:start"
+
+// GORM 通过回调统计 SQL 耗时，并注册连接池指标（go_sql_*）；name 区分主从库，如 primary、replica-0。
+// p 为 nil 时不统计，供不暴露指标的命令行工具使用
+func (p *Prometheus) GORM(db *gorm.DB, name string) error {
+	if p == nil {
+		return nil
+	}
+	sqldb, err := db.DB()
+	if err != nil {
+		return err
+	}
+	if err := p.registerer.Register(collectors.NewDBStatsCollector(sqldb, name)); err != nil {
+		return err
+	}
+
+	before := func(db *gorm.DB) {
+		db.InstanceSet(gormStartKey, time.Now())
+	}
+	after := func(operation string) func(*gorm.DB) {
+		return func(db *gorm.DB) {
+			v, ok := db.InstanceGet(gormStartKey)
+			if !ok {
+				return
+			}
+			start, ok := v.(time.Time)
+			if !ok {
+				return
+			}
+			result := "ok"
+			if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+				result = "error"
+			}
+			p.dbSeconds.WithLabelValues(name, operation, db.Statement.Table, result).Observe(time.Since(start).Seconds())
+		}
+	}
+
+	cb := db.Callback()
+	for _, err := range []error{
+		cb.Create().Before("gorm:create").Register("metrics:before_create", before),
+		cb.Create().After("gorm:create").Register("metrics:after_create", after("create")),
+		cb.Query().Before("gorm:query").Register("metrics:before_query", before),
+		cb.Query().After("gorm:query").Register("metrics:after_query", after("query")),
+		cb.Update().Before("gorm:update").Register("metrics:before_update", before),
+		cb.Update().After("gorm:update").Register("metrics:after_update", after("update")),
+		cb.Delete().Before("gorm:delete").Register("metrics:before_delete", before),
+		cb.Delete().After("gorm:delete").Register("metrics:after_delete", after("delete")),
+		cb.Row().Before("gorm:row").Register("metrics:before_row", before),
+		cb.Row().After("gorm:row").Register("metrics:after_row", after("row")),
+		cb.Raw().Before("gorm:raw").Register("metrics:before_raw", before),
+		cb.Raw().After("gorm:raw").Register("metrics:after_raw", after("raw")),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
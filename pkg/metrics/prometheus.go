@@ -0,0 +1,175 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/go-kratos/kratos/v2/metrics"
+	"github.com/go-kratos/kratos/v2/middleware"
+	mmd "github.com/go-kratos/kratos/v2/middleware/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Prometheus 服务的 prometheus 指标，通过 Handler 挂载到 /metrics；
+// 注册的全部指标（包括 Go 运行时与进程指标）都带有 service_name、service_version 标签
+type Prometheus struct {
+	reg        *prometheus.Registry
+	registerer prometheus.Registerer
+
+	serverRequests *prometheus.CounterVec
+	serverSeconds  *prometheus.HistogramVec
+	clientRequests *prometheus.CounterVec
+	clientSeconds  *prometheus.HistogramVec
+	dbSeconds      *prometheus.HistogramVec
+	redisSeconds   *prometheus.HistogramVec
+}
+
+// NewPrometheus name、version 为 main.Name、main.Version
+func NewPrometheus(name, version string) *Prometheus {
+	reg := prometheus.NewRegistry()
+	p := &Prometheus{
+		reg:        reg,
+		registerer: prometheus.WrapRegistererWith(prometheus.Labels{"service_name": name, "service_version": version}, reg),
+		serverRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "server",
+			Subsystem: "requests",
+			Name:      "code_total",
+			Help:      "The total number of processed requests",
+		}, []string{"kind", "operation", "code", "reason"}),
+		serverSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "server",
+			Subsystem: "requests",
+			Name:      "duration_seconds",
+			Help:      "Requests duration(sec).",
+			Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+		}, []string{"kind", "operation"}),
+		clientRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "client",
+			Subsystem: "requests",
+			Name:      "code_total",
+			Help:      "The total number of downstream requests",
+		}, []string{"kind", "operation", "code", "reason"}),
+		clientSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "client",
+			Subsystem: "requests",
+			Name:      "duration_seconds",
+			Help:      "Downstream requests duration(sec).",
+			Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+		}, []string{"kind", "operation"}),
+		dbSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "gorm",
+			Subsystem: "query",
+			Name:      "duration_seconds",
+			Help:      "SQL execution duration(sec).",
+			Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+		}, []string{"db", "operation", "table", "result"}),
+		redisSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "redis",
+			Subsystem: "command",
+			Name:      "duration_seconds",
+			Help:      "Redis command duration(sec).",
+			Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5},
+		}, []string{"client", "command", "result"}),
+	}
+	p.registerer.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		p.serverRequests, p.serverSeconds,
+		p.clientRequests, p.clientSeconds,
+		p.dbSeconds, p.redisSeconds,
+	)
+	return p
+}
+
+// Registerer 注册业务自定义的指标，同样带有 service_name、service_version 标签
+func (p *Prometheus) Registerer() prometheus.Registerer {
+	return p.registerer
+}
+
+// Handler /metrics
+func (p *Prometheus) Handler() http.Handler {
+	return promhttp.HandlerFor(p.reg, promhttp.HandlerOpts{Registry: p.reg})
+}
+
+// Server 服务端按 kind、operation、code、reason 统计请求数，按 kind、operation 统计耗时
+func (p *Prometheus) Server() middleware.Middleware {
+	return mmd.Server(
+		mmd.WithRequests(NewPromCounter(p.serverRequests)),
+		mmd.WithSeconds(NewPromObserver(p.serverSeconds)),
+	)
+}
+
+// Client 调用下游服务的请求数与耗时
+func (p *Prometheus) Client() middleware.Middleware {
+	return mmd.Client(
+		mmd.WithRequests(NewPromCounter(p.clientRequests)),
+		mmd.WithSeconds(NewPromObserver(p.clientSeconds)),
+	)
+}
+
+type promCounter struct {
+	cv  *prometheus.CounterVec
+	lvs []string
+}
+
+// NewPromCounter prometheus CounterVec 转为 kratos metrics.Counter
+func NewPromCounter(cv *prometheus.CounterVec) metrics.Counter {
+	return &promCounter{cv: cv}
+}
+
+func (c *promCounter) With(lvs ...string) metrics.Counter {
+	return &promCounter{cv: c.cv, lvs: lvs}
+}
+
+func (c *promCounter) Inc() {
+	c.cv.WithLabelValues(c.lvs...).Inc()
+}
+
+func (c *promCounter) Add(delta float64) {
+	c.cv.WithLabelValues(c.lvs...).Add(delta)
+}
+
+type promObserver struct {
+	ov  prometheus.ObserverVec
+	lvs []string
+}
+
+// NewPromObserver prometheus HistogramVec、SummaryVec 转为 kratos metrics.Observer
+func NewPromObserver(ov prometheus.ObserverVec) metrics.Observer {
+	return &promObserver{ov: ov}
+}
+
+func (o *promObserver) With(lvs ...string) metrics.Observer {
+	return &promObserver{ov: o.ov, lvs: lvs}
+}
+
+func (o *promObserver) Observe(v float64) {
+	o.ov.WithLabelValues(o.lvs...).Observe(v)
+}
+
+type promGauge struct {
+	gv  *prometheus.GaugeVec
+	lvs []string
+}
+
+// NewPromGauge prometheus GaugeVec 转为 kratos metrics.Gauge
+func NewPromGauge(gv *prometheus.GaugeVec) metrics.Gauge {
+	return &promGauge{gv: gv}
+}
+
+func (g *promGauge) With(lvs ...string) metrics.Gauge {
+	return &promGauge{gv: g.gv, lvs: lvs}
+}
+
+func (g *promGauge) Set(v float64) {
+	g.gv.WithLabelValues(g.lvs...).Set(v)
+}
+
+func (g *promGauge) Add(delta float64) {
+	g.gv.WithLabelValues(g.lvs...).Add(delta)
+}
+
+func (g *promGauge) Sub(delta float64) {
+	g.gv.WithLabelValues(g.lvs...).Sub(delta)
+}
@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+func scrape(t *testing.T, p *Prometheus) string {
+	t.Helper()
+	w := httptest.NewRecorder()
+	p.Handler().ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	body, err := io.ReadAll(w.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body)
+}
+
+func TestPrometheus(t *testing.T) {
+	p := NewPrometheus("casso.user.service", "v1")
+	NewPromCounter(p.serverRequests).With("grpc", "/api.user.service.v1.User/GetUser", "200", "").Inc()
+	NewPromObserver(p.serverSeconds).With("grpc", "/api.user.service.v1.User/GetUser").Observe(0.02)
+
+	body := scrape(t, p)
+	for _, want := range []string{
+		`server_requests_code_total{code="200",kind="grpc",operation="/api.user.service.v1.User/GetUser",reason="",service_name="casso.user.service",service_version="v1"} 1`,
+		`server_requests_duration_seconds_count{kind="grpc",operation="/api.user.service.v1.User/GetUser",service_name="casso.user.service",service_version="v1"} 1`,
+		`go_goroutines{service_name="casso.user.service",service_version="v1"}`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("missing %s", want)
+		}
+	}
+}
+
+func TestRedis(t *testing.T) {
+	p := NewPrometheus("casso.user.service", "v1")
+	rd := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", DialTimeout: 100 * time.Millisecond, MaxRetries: -1})
+	defer rd.Close()
+	if err := p.Redis(rd, "default"); err != nil {
+		t.Fatal(err)
+	}
+	_ = rd.Get(context.Background(), "key").Err()
+
+	body := scrape(t, p)
+	for _, want := range []string{
+		`redis_command_duration_seconds_count{client="default",command="get",result="error",service_name="casso.user.service",service_version="v1"} 1`,
+		`redis_pool_conns{client="default",service_name="casso.user.service",service_version="v1"} 0`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("missing %s", want)
+		}
+	}
+}
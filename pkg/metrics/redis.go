@@ -0,0 +1,110 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Redis 通过 hook 统计命令耗时，并注册连接池指标；name 区分同一服务中的多个客户端
+func (p *Prometheus) Redis(rd *redis.Client, name string) error {
+	if err := p.registerer.Register(newPoolCollector(rd, name)); err != nil {
+		return err
+	}
+	rd.AddHook(&redisHook{seconds: p.redisSeconds, name: name})
+	return nil
+}
+
+type redisStartKey struct{}
+
+type redisHook struct {
+	seconds *prometheus.HistogramVec
+	name    string
+}
+
+func (h *redisHook) BeforeProcess(ctx context.Context, cmd redis.Cmder) (context.Context, error) {
+	return context.WithValue(ctx, redisStartKey{}, time.Now()), nil
+}
+
+func (h *redisHook) AfterProcess(ctx context.Context, cmd redis.Cmder) error {
+	h.observe(ctx, cmd.Name(), cmd.Err())
+	return nil
+}
+
+func (h *redisHook) BeforeProcessPipeline(ctx context.Context, cmds []redis.Cmder) (context.Context, error) {
+	return context.WithValue(ctx, redisStartKey{}, time.Now()), nil
+}
+
+// AfterProcessPipeline 整个 pipeline 记为一次 pipeline 命令
+func (h *redisHook) AfterProcessPipeline(ctx context.Context, cmds []redis.Cmder) error {
+	var err error
+	for _, cmd := range cmds {
+		if cmd.Err() != nil {
+			err = cmd.Err()
+			break
+		}
+	}
+	h.observe(ctx, "pipeline", err)
+	return nil
+}
+
+func (h *redisHook) observe(ctx context.Context, command string, err error) {
+	start, ok := ctx.Value(redisStartKey{}).(time.Time)
+	if !ok {
+		return
+	}
+	result := "ok"
+	if err != nil && !errors.Is(err, redis.Nil) {
+		result = "error"
+	}
+	h.seconds.WithLabelValues(h.name, command, result).Observe(time.Since(start).Seconds())
+}
+
+// poolCollector 采集时读取 redis 连接池状态
+type poolCollector struct {
+	rd       *redis.Client
+	hits     *prometheus.Desc
+	misses   *prometheus.Desc
+	timeouts *prometheus.Desc
+	total    *prometheus.Desc
+	idle     *prometheus.Desc
+	stale    *prometheus.Desc
+}
+
+func newPoolCollector(rd *redis.Client, name string) *poolCollector {
+	labels := prometheus.Labels{"client": name}
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName("redis", "pool", name), help, nil, labels)
+	}
+	return &poolCollector{
+		rd:       rd,
+		hits:     desc("hits_total", "Number of times free connection was found in the pool."),
+		misses:   desc("misses_total", "Number of times free connection was NOT found in the pool."),
+		timeouts: desc("timeouts_total", "Number of times a wait timeout occurred."),
+		total:    desc("conns", "Number of total connections in the pool."),
+		idle:     desc("idle_conns", "Number of idle connections in the pool."),
+		stale:    desc("stale_conns_total", "Number of stale connections removed from the pool."),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.hits
+	ch <- c.misses
+	ch <- c.timeouts
+	ch <- c.total
+	ch <- c.idle
+	ch <- c.stale
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.rd.PoolStats()
+	ch <- prometheus.MustNewConstMetric(c.hits, prometheus.CounterValue, float64(s.Hits))
+	ch <- prometheus.MustNewConstMetric(c.misses, prometheus.CounterValue, float64(s.Misses))
+	ch <- prometheus.MustNewConstMetric(c.timeouts, prometheus.CounterValue, float64(s.Timeouts))
+	ch <- prometheus.MustNewConstMetric(c.total, prometheus.GaugeValue, float64(s.TotalConns))
+	ch <- prometheus.MustNewConstMetric(c.idle, prometheus.GaugeValue, float64(s.IdleConns))
+	ch <- prometheus.MustNewConstMetric(c.stale, prometheus.CounterValue, float64(s.StaleConns))
+}
@@ -0,0 +1,104 @@
+package migrate
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// CLI 各服务 cmd/migrate 共用的命令行入口
+//
+//	migrate [-dry-run] [-y] up [N]    执行 N 个（默认全部）未应用的迁移
+//	migrate [-dry-run] [-y] down [N]  回滚 N 个（默认 1 个）迁移
+//	migrate status                    查看迁移状态
+//
+// 生产环境的 DDL 需要先经过审核：未指定 -y 时先打印将要执行的 SQL，确认后才会执行
+type CLI struct {
+	DB         *sql.DB
+	Migrations []*Migration
+	LockName   string
+	DryRun     bool
+	Yes        bool
+	In         io.Reader
+	Out        io.Writer
+}
+
+// Run 执行命令
+func (c *CLI) Run(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("migrate: missing command, want up|down|status")
+	}
+	steps := 0
+	if len(args) > 1 {
+		n, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("migrate: invalid steps %q", args[1])
+		}
+		steps = n
+	}
+
+	newMigrator := func(dryRun bool) *Migrator {
+		return New(c.DB, c.Migrations, WithLockName(c.LockName), WithDryRun(dryRun), WithOutput(c.Out))
+	}
+
+	switch args[0] {
+	case "status":
+		return c.status(ctx, newMigrator(false))
+	case "up", "down":
+		run := func(m *Migrator) error {
+			if args[0] == "up" {
+				return m.Up(ctx, steps)
+			}
+			return m.Down(ctx, steps)
+		}
+		if c.DryRun {
+			return run(newMigrator(true))
+		}
+		if !c.Yes {
+			if err := run(newMigrator(true)); err != nil {
+				return err
+			}
+			if !c.confirm() {
+				fmt.Fprintln(c.Out, "aborted")
+				return nil
+			}
+		}
+		return run(newMigrator(false))
+	default:
+		return fmt.Errorf("migrate: unknown command %q, want up|down|status", args[0])
+	}
+}
+
+func (c *CLI) status(ctx context.Context, m *Migrator) error {
+	list, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(c.Out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+	for _, st := range list {
+		status, at := "pending", ""
+		if st.Applied {
+			status = "applied"
+			at = time.Unix(st.AppliedTime, 0).Format("2006-01-02 15:04:05")
+		}
+		if st.Dirty {
+			status = "dirty"
+		}
+		fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", st.Version, st.Name, status, at)
+	}
+	return w.Flush()
+}
+
+func (c *CLI) confirm() bool {
+	fmt.Fprint(c.Out, "Apply the SQL above? [y/N]: ")
+	line, _ := bufio.NewReader(c.In).ReadString('\n')
+	line = strings.ToLower(strings.TrimSpace(line))
+	return line == "y" || line == "yes"
+}
@@ -0,0 +1,387 @@
+/*
+ * @PackageName: migrate
+ * @Description: 版本化的 SQL 迁移，替代服务启动时的 AutoMigrate
+ * 迁移文件命名: {version}_{name}.up.sql / {version}_{name}.down.sql，例如 0001_create_user.up.sql
+ * 已执行的版本记录在 schema_migrations 表中；多副本同时执行时通过 mysql GET_LOCK 串行化
+ */
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrDirty   = errors.New("migrate: database is dirty, fix the failed migration manually and reset its dirty flag")
+	ErrLocked  = errors.New("migrate: another process is running migrations")
+	ErrNoFiles = errors.New("migrate: no migration files found")
+)
+
+var fileRegexp = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// Migration 单个版本的迁移
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status 迁移执行状态
+type Status struct {
+	Version     int64
+	Name        string
+	Applied     bool
+	Dirty       bool
+	AppliedTime int64
+}
+
+// Load 从目录（通常为 embed.FS）加载迁移文件，按版本升序返回
+func Load(fsys fs.FS, dir string) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		m := fileRegexp.FindStringSubmatch(e.Name())
+		if m == nil {
+			continue
+		}
+		version, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migrate: invalid version in %s: %w", e.Name(), err)
+		}
+		data, err := fs.ReadFile(fsys, path.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		mg, ok := byVersion[version]
+		if !ok {
+			mg = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = mg
+		} else if mg.Name != m[2] {
+			return nil, fmt.Errorf("migrate: duplicate version %d (%s, %s)", version, mg.Name, m[2])
+		}
+		if m[3] == "up" {
+			mg.Up = string(data)
+		} else {
+			mg.Down = string(data)
+		}
+	}
+	if len(byVersion) == 0 {
+		return nil, ErrNoFiles
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, mg := range byVersion {
+		if mg.Up == "" {
+			return nil, fmt.Errorf("migrate: version %d missing up file", mg.Version)
+		}
+		migrations = append(migrations, mg)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Option 迁移配置
+type Option func(*Migrator)
+
+// WithTable 自定义版本记录表名，默认 schema_migrations
+func WithTable(table string) Option {
+	return func(m *Migrator) {
+		m.table = table
+	}
+}
+
+// WithLockName 自定义分布式锁名称，同一个库的多个服务应使用不同的锁
+func WithLockName(name string) Option {
+	return func(m *Migrator) {
+		m.lockName = name
+	}
+}
+
+// WithLockTimeout 等待其他副本释放锁的时间
+func WithLockTimeout(d time.Duration) Option {
+	return func(m *Migrator) {
+		m.lockTimeout = d
+	}
+}
+
+// WithDryRun 只打印将要执行的 SQL，不做任何修改
+func WithDryRun(dryRun bool) Option {
+	return func(m *Migrator) {
+		m.dryRun = dryRun
+	}
+}
+
+// WithOutput 执行过程与 dry-run SQL 的输出位置
+func WithOutput(w io.Writer) Option {
+	return func(m *Migrator) {
+		m.out = w
+	}
+}
+
+// Migrator 迁移执行器
+type Migrator struct {
+	db          *sql.DB
+	migrations  []*Migration
+	table       string
+	lockName    string
+	lockTimeout time.Duration
+	dryRun      bool
+	out         io.Writer
+}
+
+// New 新建迁移执行器
+func New(db *sql.DB, migrations []*Migration, opts ...Option) *Migrator {
+	m := &Migrator{
+		db:          db,
+		migrations:  migrations,
+		table:       "schema_migrations",
+		lockName:    "schema_migrations",
+		lockTimeout: 30 * time.Second,
+		out:         io.Discard,
+	}
+	for _, o := range opts {
+		o(m)
+	}
+	return m
+}
+
+// Up 执行未应用的迁移，steps <= 0 表示全部
+func (m *Migrator) Up(ctx context.Context, steps int) error {
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := checkDirty(applied); err != nil {
+			return err
+		}
+
+		var pending []*Migration
+		for _, mg := range m.migrations {
+			if _, ok := applied[mg.Version]; !ok {
+				pending = append(pending, mg)
+			}
+		}
+		if steps > 0 && steps < len(pending) {
+			pending = pending[:steps]
+		}
+		if len(pending) == 0 {
+			fmt.Fprintln(m.out, "no pending migrations")
+			return nil
+		}
+		for _, mg := range pending {
+			if err := m.run(ctx, conn, mg, true); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Down 回滚最近的迁移，steps <= 0 时回滚一个版本
+func (m *Migrator) Down(ctx context.Context, steps int) error {
+	if steps <= 0 {
+		steps = 1
+	}
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := m.applied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := checkDirty(applied); err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && steps > 0; i-- {
+			mg := m.migrations[i]
+			if _, ok := applied[mg.Version]; !ok {
+				continue
+			}
+			if mg.Down == "" {
+				return fmt.Errorf("migrate: version %d has no down file", mg.Version)
+			}
+			if err := m.run(ctx, conn, mg, false); err != nil {
+				return err
+			}
+			steps--
+		}
+		return nil
+	})
+}
+
+// Status 返回所有迁移的执行状态，数据库中存在但本地缺失的版本同样列出
+func (m *Migrator) Status(ctx context.Context) ([]*Status, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	applied, err := m.applied(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*Status, 0, len(m.migrations))
+	for _, mg := range m.migrations {
+		st := &Status{Version: mg.Version, Name: mg.Name}
+		if a, ok := applied[mg.Version]; ok {
+			st.Applied, st.Dirty, st.AppliedTime = true, a.Dirty, a.AppliedTime
+			delete(applied, mg.Version)
+		}
+		res = append(res, st)
+	}
+	for _, a := range applied {
+		res = append(res, a)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Version < res[j].Version
+	})
+	return res, nil
+}
+
+// run 执行单个迁移；先写入 dirty 记录，全部语句成功后再清除，失败时保留现场等待人工处理
+func (m *Migrator) run(ctx context.Context, conn *sql.Conn, mg *Migration, up bool) error {
+	direction, body := "up", mg.Up
+	if !up {
+		direction, body = "down", mg.Down
+	}
+	stmts := SplitStatements(body)
+
+	if m.dryRun {
+		fmt.Fprintf(m.out, "-- %04d_%s (%s)\n", mg.Version, mg.Name, direction)
+		for _, s := range stmts {
+			fmt.Fprintf(m.out, "%s;\n", s)
+		}
+		fmt.Fprintln(m.out)
+		return nil
+	}
+
+	fmt.Fprintf(m.out, "migrating %04d_%s (%s) ... ", mg.Version, mg.Name, direction)
+	start := time.Now()
+	if up {
+		_, err := conn.ExecContext(ctx, fmt.Sprintf("INSERT INTO `%s` (version, name, dirty, applied_time) VALUES (?, ?, 1, ?)", m.table), mg.Version, mg.Name, time.Now().Unix())
+		if err != nil {
+			return err
+		}
+	} else {
+		_, err := conn.ExecContext(ctx, fmt.Sprintf("UPDATE `%s` SET dirty = 1 WHERE version = ?", m.table), mg.Version)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, s := range stmts {
+		if _, err := conn.ExecContext(ctx, s); err != nil {
+			fmt.Fprintln(m.out, "failed")
+			return fmt.Errorf("migrate: %04d_%s (%s): %w", mg.Version, mg.Name, direction, err)
+		}
+	}
+
+	var err error
+	if up {
+		_, err = conn.ExecContext(ctx, fmt.Sprintf("UPDATE `%s` SET dirty = 0 WHERE version = ?", m.table), mg.Version)
+	} else {
+		_, err = conn.ExecContext(ctx, fmt.Sprintf("DELETE FROM `%s` WHERE version = ?", m.table), mg.Version)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(m.out, "done (%s)\n", time.Since(start).Round(time.Millisecond))
+	return nil
+}
+
+// withLock 在同一个连接上持有 mysql 命名锁并执行迁移
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if m.dryRun {
+		// dry-run 不修改数据库，无需加锁与建表
+		return fn(conn)
+	}
+
+	var got sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", m.lockName, int(m.lockTimeout.Seconds())).Scan(&got); err != nil {
+		return err
+	}
+	if !got.Valid || got.Int64 != 1 {
+		return ErrLocked
+	}
+	defer conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", m.lockName) //nolint:errcheck
+
+	if err := m.ensureTable(ctx, conn); err != nil {
+		return err
+	}
+	return fn(conn)
+}
+
+func (m *Migrator) ensureTable(ctx context.Context, conn *sql.Conn) error {
+	_, err := conn.ExecContext(ctx, fmt.Sprintf("CREATE TABLE IF NOT EXISTS `%s` ("+
+		"`version` bigint NOT NULL,"+
+		"`name` varchar(255) NOT NULL DEFAULT '',"+
+		"`dirty` tinyint(1) NOT NULL DEFAULT 0,"+
+		"`applied_time` bigint(20) NOT NULL DEFAULT 0,"+
+		"PRIMARY KEY (`version`))", m.table))
+	return err
+}
+
+// applied 查询已执行的版本；表不存在时视为没有执行过任何迁移
+func (m *Migrator) applied(ctx context.Context, conn *sql.Conn) (map[int64]*Status, error) {
+	res := make(map[int64]*Status)
+
+	var exists int
+	err := conn.QueryRowContext(ctx, "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?", m.table).Scan(&exists)
+	if err != nil {
+		return nil, err
+	}
+	if exists == 0 {
+		return res, nil
+	}
+
+	rows, err := conn.QueryContext(ctx, fmt.Sprintf("SELECT version, name, dirty, applied_time FROM `%s`", m.table))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		st := &Status{Applied: true}
+		if err := rows.Scan(&st.Version, &st.Name, &st.Dirty, &st.AppliedTime); err != nil {
+			return nil, err
+		}
+		res[st.Version] = st
+	}
+	return res, rows.Err()
+}
+
+func checkDirty(applied map[int64]*Status) error {
+	for _, a := range applied {
+		if a.Dirty {
+			return fmt.Errorf("%w (version %d)", ErrDirty, a.Version)
+		}
+	}
+	return nil
+}
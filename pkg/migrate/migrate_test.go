@@ -0,0 +1,62 @@
+package migrate
+
+import (
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"sql/0002_add_index.up.sql":      {Data: []byte("CREATE INDEX idx ON t (a);")},
+		"sql/0002_add_index.down.sql":    {Data: []byte("DROP INDEX idx ON t;")},
+		"sql/0001_create_t.up.sql":       {Data: []byte("CREATE TABLE t (a int);")},
+		"sql/0001_create_t.down.sql":     {Data: []byte("DROP TABLE t;")},
+		"sql/README.md":                  {Data: []byte("ignored")},
+		"sql/0003_seed_data.up.sql":      {Data: []byte("INSERT INTO t VALUES (1);")},
+		"other/0009_not_loaded.up.sql":   {Data: []byte("SELECT 1;")},
+		"other/0009_not_loaded.down.sql": {Data: []byte("SELECT 1;")},
+	}
+	ms, err := Load(fsys, "sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var versions []int64
+	for _, m := range ms {
+		versions = append(versions, m.Version)
+	}
+	if !reflect.DeepEqual(versions, []int64{1, 2, 3}) {
+		t.Fatalf("versions = %v", versions)
+	}
+	if ms[2].Down != "" {
+		t.Fatalf("version 3 should have no down migration")
+	}
+}
+
+func TestLoadMissingUp(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0001_create_t.down.sql": {Data: []byte("DROP TABLE t;")},
+	}
+	if _, err := Load(fsys, "."); err == nil {
+		t.Fatal("expected error for missing up file")
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+	body := `
+-- 用户表
+CREATE TABLE t (
+	a varchar(10) DEFAULT ';', # 行内注释
+	b varchar(10) COMMENT 'it''s; fine'
+);
+/* 块注释; */
+INSERT INTO t VALUES ("x;y", 'z');
+`
+	got := SplitStatements(body)
+	if len(got) != 2 {
+		t.Fatalf("got %d statements: %q", len(got), got)
+	}
+	if got[1] != `INSERT INTO t VALUES ("x;y", 'z')` {
+		t.Fatalf("unexpected statement: %q", got[1])
+	}
+}
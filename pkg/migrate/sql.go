@@ -0,0 +1,63 @@
+package migrate
+
+import "strings"
+
+// SplitStatements 按分号拆分 SQL 语句，忽略引号内的分号与注释
+// mysql 驱动默认不允许一次执行多条语句，迁移文件中的语句需要逐条执行
+func SplitStatements(body string) []string {
+	var (
+		stmts []string
+		buf   strings.Builder
+		quote rune // 当前所在的引号 ' " `
+		runes = []rune(body)
+	)
+
+	flush := func() {
+		if s := strings.TrimSpace(buf.String()); s != "" {
+			stmts = append(stmts, s)
+		}
+		buf.Reset()
+	}
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if quote != 0 {
+			buf.WriteRune(r)
+			if r == '\\' && quote != '`' && i+1 < len(runes) {
+				i++
+				buf.WriteRune(runes[i])
+				continue
+			}
+			if r == quote {
+				quote = 0
+			}
+			continue
+		}
+
+		switch {
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+			buf.WriteRune(r)
+		case r == '#' || (r == '-' && i+1 < len(runes) && runes[i+1] == '-'):
+			// 行注释
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+			buf.WriteRune('\n')
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			// 块注释
+			i += 2
+			for i+1 < len(runes) && !(runes[i] == '*' && runes[i+1] == '/') {
+				i++
+			}
+			i++
+			buf.WriteRune(' ')
+		case r == ';':
+			flush()
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	flush()
+	return stmts
+}
@@ -0,0 +1,68 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+)
+
+// Split 平均拆分为 n 份，零头从第一份开始每份多分 1 个最小单位，如 10.00 拆 3 份为 3.34 3.33 3.33
+func (m Money) Split(n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, errors.New("money: split into non-positive parts")
+	}
+	ratios := make([]int64, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return m.Allocate(ratios...)
+}
+
+// Allocate 按比例分摊（如按商品价格分摊优惠），各部分之和严格等于原金额
+// 先按比例向 0 截断，剩余的零头按最大余数法逐个分配，余数相同时靠前的优先
+func (m Money) Allocate(ratios ...int64) ([]Money, error) {
+	if len(ratios) == 0 {
+		return nil, errors.New("money: allocate without ratios")
+	}
+	total := new(big.Int)
+	for _, r := range ratios {
+		if r < 0 {
+			return nil, errors.New("money: negative ratio")
+		}
+		total.Add(total, big.NewInt(r))
+	}
+	if total.Sign() == 0 {
+		return nil, errors.New("money: ratios sum to zero")
+	}
+
+	type part struct {
+		index int
+		rem   *big.Int
+	}
+	var (
+		amount = big.NewInt(m.amount)
+		res    = make([]Money, len(ratios))
+		parts  = make([]part, len(ratios))
+		left   = m.amount
+	)
+	for i, r := range ratios {
+		share := new(big.Int).Mul(amount, big.NewInt(r))
+		q, rem := new(big.Int).QuoRem(share, total, new(big.Int))
+		res[i] = Money{amount: q.Int64(), currency: m.currency}
+		parts[i] = part{index: i, rem: rem.Abs(rem)}
+		left -= q.Int64()
+	}
+
+	sort.SliceStable(parts, func(i, j int) bool {
+		return parts[i].rem.Cmp(parts[j].rem) > 0
+	})
+	step := int64(1)
+	if left < 0 {
+		step = -1
+	}
+	for i := 0; left != 0; i++ {
+		res[parts[i%len(parts)].index].amount += step
+		left -= step
+	}
+	return res, nil
+}
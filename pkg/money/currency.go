@@ -0,0 +1,62 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Currency ISO 4217 货币代码
+type Currency string
+
+const (
+	CNY Currency = "CNY"
+	USD Currency = "USD"
+	EUR Currency = "EUR"
+	HKD Currency = "HKD"
+	TWD Currency = "TWD"
+	GBP Currency = "GBP"
+	JPY Currency = "JPY"
+	KRW Currency = "KRW"
+)
+
+var (
+	mu sync.RWMutex
+	// 货币的小数位数（最小货币单位），如 CNY 为 2（分），JPY 为 0
+	exponents = map[Currency]int{
+		CNY: 2, USD: 2, EUR: 2, HKD: 2, TWD: 2, GBP: 2,
+		JPY: 0, KRW: 0,
+		"BHD": 3, "KWD": 3,
+	}
+)
+
+// RegisterCurrency 注册货币及其小数位数
+func RegisterCurrency(c Currency, exponent int) {
+	mu.Lock()
+	defer mu.Unlock()
+	exponents[c] = exponent
+}
+
+// ParseCurrency 解析货币代码，大小写不敏感，未注册的货币返回 ErrUnknownCurrency
+func ParseCurrency(code string) (Currency, error) {
+	c := Currency(strings.ToUpper(strings.TrimSpace(code)))
+	if _, ok := c.exponent(); !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownCurrency, code)
+	}
+	return c, nil
+}
+
+// Exponent 小数位数，未注册的货币返回 -1
+func (c Currency) Exponent() int {
+	if e, ok := c.exponent(); ok {
+		return e
+	}
+	return -1
+}
+
+func (c Currency) exponent() (int, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	e, ok := exponents[c]
+	return e, ok
+}
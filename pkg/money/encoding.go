@@ -0,0 +1,112 @@
+package money
+
+import (
+	"bytes"
+	v1 "casso/api/common/v1"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type jsonMoney struct {
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// MarshalJSON 编码为 {"amount":"12.34","currency":"CNY"}，金额使用字符串避免前端浮点误差；
+// 零值（未设置货币）编码为 null
+func (m Money) MarshalJSON() ([]byte, error) {
+	if m.currency == "" {
+		if m.amount != 0 {
+			return nil, fmt.Errorf("%w: %d without currency", ErrUnknownCurrency, m.amount)
+		}
+		return []byte("null"), nil
+	}
+	return json.Marshal(jsonMoney{Amount: m.Decimal(), Currency: string(m.currency)})
+}
+
+// UnmarshalJSON 解码 MarshalJSON 的格式，null 不修改 m
+func (m *Money) UnmarshalJSON(b []byte) error {
+	if string(bytes.TrimSpace(b)) == "null" {
+		return nil
+	}
+	var v jsonMoney
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	c, err := ParseCurrency(v.Currency)
+	if err != nil {
+		return err
+	}
+	res, err := Parse(v.Amount, c)
+	if err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// Value 实现 driver.Valuer，以 "12.34 CNY" 的形式存入单个字符串列，零值（未设置货币）存为 NULL
+// 需要在 SQL 中排序或求和的金额应拆为 int64 最小单位列与货币列分别存储
+func (m Money) Value() (driver.Value, error) {
+	if m.currency == "" {
+		if m.amount != 0 {
+			return nil, fmt.Errorf("%w: %d without currency", ErrUnknownCurrency, m.amount)
+		}
+		return nil, nil
+	}
+	return m.String(), nil
+}
+
+// Scan 实现 sql.Scanner，NULL 扫描为零值
+func (m *Money) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case nil:
+		*m = Money{}
+		return nil
+	default:
+		return fmt.Errorf("money: cannot scan %T", src)
+	}
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	c, err := ParseCurrency(fields[1])
+	if err != nil {
+		return err
+	}
+	res, err := Parse(fields[0], c)
+	if err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// GormDataType 列类型
+func (Money) GormDataType() string {
+	return "varchar(32)"
+}
+
+// ToProto 转为 api/common/v1.Money
+func (m Money) ToProto() *v1.Money {
+	return &v1.Money{Amount: m.amount, Currency: string(m.currency)}
+}
+
+// FromProto 由 api/common/v1.Money 转换，nil 或未注册的货币返回错误
+func FromProto(p *v1.Money) (Money, error) {
+	if p == nil {
+		return Money{}, fmt.Errorf("%w: nil", ErrInvalidAmount)
+	}
+	c, err := ParseCurrency(p.Currency)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{amount: p.Amount, currency: c}, nil
+}
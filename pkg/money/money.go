@@ -0,0 +1,215 @@
+/*
+ * @PackageName: money
+ * @Description: 金额类型，使用最小货币单位的整数（如分）加 ISO 4217 货币代码表示
+ * 所有运算都是精确的：加减乘检查溢出，涉及比例（折扣、手续费）的运算显式指定舍入方式，
+ * 拆分与分摊保证各部分之和等于原金额，不会丢失零头：
+ *   price := money.New(1999, money.CNY)                         // 19.99 元
+ *   fee, _ := price.MulRat(big.NewRat(6, 1000), money.HalfEven) // 千分之六手续费
+ *   parts, _ := price.Allocate(1, 1, 1)                         // 6.67 + 6.66 + 6.66
+ */
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+var (
+	ErrCurrencyMismatch = errors.New("money: currency mismatch")
+	ErrUnknownCurrency  = errors.New("money: unknown currency")
+	ErrOverflow         = errors.New("money: amount overflows int64")
+	ErrInvalidAmount    = errors.New("money: invalid amount")
+)
+
+// Money 金额，零值表示没有货币的 0
+type Money struct {
+	amount   int64
+	currency Currency
+}
+
+// New 使用最小货币单位创建金额，如 New(1234, CNY) 表示 12.34 元
+func New(amount int64, currency Currency) Money {
+	return Money{amount: amount, currency: currency}
+}
+
+// Zero 指定货币的 0
+func Zero(currency Currency) Money {
+	return Money{currency: currency}
+}
+
+// Parse 解析十进制金额，如 Parse("12.34", CNY)；小数位超过货币精度时返回错误
+func Parse(s string, currency Currency) (Money, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok || strings.ContainsAny(s, "/eE") {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	m, err := FromRat(r, currency, Exact)
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %q", err, s)
+	}
+	return m, nil
+}
+
+// FromRat 将以主货币单位表示的有理数（如 12.345 元）按 mode 舍入为金额
+func FromRat(r *big.Rat, currency Currency, mode RoundingMode) (Money, error) {
+	exp, ok := currency.exponent()
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %q", ErrUnknownCurrency, currency)
+	}
+	minor := new(big.Rat).Mul(r, new(big.Rat).SetInt(pow10(exp)))
+	amount, err := round(minor, mode)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{amount: amount, currency: currency}, nil
+}
+
+// Amount 最小货币单位金额
+func (m Money) Amount() int64 {
+	return m.amount
+}
+
+// Currency 货币
+func (m Money) Currency() Currency {
+	return m.currency
+}
+
+// Rat 以主货币单位表示的精确值
+func (m Money) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(big.NewInt(m.amount), pow10(m.currency.Exponent()))
+}
+
+// Decimal 十进制字符串，如 "12.34"
+func (m Money) Decimal() string {
+	exp := m.currency.Exponent()
+	if exp <= 0 {
+		return fmt.Sprintf("%d", m.amount)
+	}
+	sign, abs := "", new(big.Int).SetInt64(m.amount)
+	if m.amount < 0 {
+		sign = "-"
+		abs.Neg(abs)
+	}
+	digits := fmt.Sprintf("%0*s", exp+1, abs.String())
+	return sign + digits[:len(digits)-exp] + "." + digits[len(digits)-exp:]
+}
+
+// String 如 "12.34 CNY"
+func (m Money) String() string {
+	return m.Decimal() + " " + string(m.currency)
+}
+
+// IsZero 是否为 0
+func (m Money) IsZero() bool {
+	return m.amount == 0
+}
+
+// IsNegative 是否为负数
+func (m Money) IsNegative() bool {
+	return m.amount < 0
+}
+
+// SameCurrency 货币是否相同
+func (m Money) SameCurrency(o Money) bool {
+	return m.currency == o.currency
+}
+
+func (m Money) check(o Money) error {
+	if m.currency != o.currency {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency, o.currency)
+	}
+	return nil
+}
+
+// Cmp 比较大小，返回 -1、0、1
+func (m Money) Cmp(o Money) (int, error) {
+	if err := m.check(o); err != nil {
+		return 0, err
+	}
+	switch {
+	case m.amount < o.amount:
+		return -1, nil
+	case m.amount > o.amount:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// Equal 金额与货币都相同
+func (m Money) Equal(o Money) bool {
+	return m == o
+}
+
+// Add 加法
+func (m Money) Add(o Money) (Money, error) {
+	if err := m.check(o); err != nil {
+		return Money{}, err
+	}
+	c := m.amount + o.amount
+	if (c > m.amount) != (o.amount > 0) {
+		return Money{}, ErrOverflow
+	}
+	return Money{amount: c, currency: m.currency}, nil
+}
+
+// Sub 减法
+func (m Money) Sub(o Money) (Money, error) {
+	if err := m.check(o); err != nil {
+		return Money{}, err
+	}
+	c := m.amount - o.amount
+	if (c < m.amount) != (o.amount > 0) {
+		return Money{}, ErrOverflow
+	}
+	return Money{amount: c, currency: m.currency}, nil
+}
+
+// Neg 取反
+func (m Money) Neg() (Money, error) {
+	if m.amount == -1<<63 {
+		return Money{}, ErrOverflow
+	}
+	return Money{amount: -m.amount, currency: m.currency}, nil
+}
+
+// Mul 乘以整数，如单价乘数量
+func (m Money) Mul(n int64) (Money, error) {
+	c := new(big.Int).Mul(big.NewInt(m.amount), big.NewInt(n))
+	if !c.IsInt64() {
+		return Money{}, ErrOverflow
+	}
+	return Money{amount: c.Int64(), currency: m.currency}, nil
+}
+
+// MulRat 乘以比例并按 mode 舍入，如折扣 big.NewRat(85, 100)、手续费率 ParseRate("0.006")
+func (m Money) MulRat(r *big.Rat, mode RoundingMode) (Money, error) {
+	c := new(big.Rat).Mul(new(big.Rat).SetInt64(m.amount), r)
+	amount, err := round(c, mode)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{amount: amount, currency: m.currency}, nil
+}
+
+// ParseRate 精确解析十进制比例，如 "0.2"、"85%"，避免 float64 的二进制误差
+func ParseRate(s string) (*big.Rat, error) {
+	s = strings.TrimSpace(s)
+	percent := strings.HasSuffix(s, "%")
+	r, ok := new(big.Rat).SetString(strings.TrimSuffix(s, "%"))
+	if !ok {
+		return nil, fmt.Errorf("money: invalid rate %q", s)
+	}
+	if percent {
+		r.Quo(r, big.NewRat(100, 1))
+	}
+	return r, nil
+}
+
+func pow10(n int) *big.Int {
+	if n <= 0 {
+		return big.NewInt(1)
+	}
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,177 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestParseAndDecimal(t *testing.T) {
+	cases := []struct {
+		in       string
+		currency Currency
+		amount   int64
+		out      string
+	}{
+		{"12.34", CNY, 1234, "12.34"},
+		{"0.05", CNY, 5, "0.05"},
+		{"-0.5", USD, -50, "-0.50"},
+		{"100", JPY, 100, "100"},
+		{"1.234", "BHD", 1234, "1.234"},
+	}
+	for _, c := range cases {
+		m, err := Parse(c.in, c.currency)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", c.in, err)
+		}
+		if m.Amount() != c.amount || m.Decimal() != c.out {
+			t.Fatalf("Parse(%q) = %d %q", c.in, m.Amount(), m.Decimal())
+		}
+	}
+	for _, bad := range []string{"1.234", "abc", "1/3", "1e3"} {
+		if _, err := Parse(bad, CNY); err == nil {
+			t.Fatalf("Parse(%q) should fail", bad)
+		}
+	}
+	if _, err := Parse("1", "XXX"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("err = %v", err)
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	a, b := New(1999, CNY), New(1, CNY)
+	if sum, _ := a.Add(b); sum.Amount() != 2000 {
+		t.Fatalf("sum = %v", sum)
+	}
+	if _, err := a.Add(New(1, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Fatalf("err = %v", err)
+	}
+	if _, err := New(math.MaxInt64, CNY).Add(b); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("err = %v", err)
+	}
+	if _, err := New(math.MinInt64, CNY).Sub(b); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("err = %v", err)
+	}
+	if _, err := New(math.MaxInt64/2+1, CNY).Mul(2); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("err = %v", err)
+	}
+}
+
+func TestRounding(t *testing.T) {
+	cases := []struct {
+		amount int64
+		rate   string
+		mode   RoundingMode
+		want   int64
+	}{
+		{25, "0.1", HalfEven, 2}, // 2.5 -> 2
+		{35, "0.1", HalfEven, 4}, // 3.5 -> 4
+		{25, "0.1", HalfUp, 3},   // 2.5 -> 3
+		{-25, "0.1", HalfUp, -3}, // -2.5 -> -3
+		{-25, "0.1", HalfEven, -2},
+		{29, "0.1", Down, 2},
+		{1000, "0.2", HalfEven, 200},
+		{1999, "85%", HalfUp, 1699}, // 1699.15
+	}
+	for _, c := range cases {
+		r, err := ParseRate(c.rate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := New(c.amount, CNY).MulRat(r, c.mode)
+		if err != nil || got.Amount() != c.want {
+			t.Fatalf("%d * %s (%v) = %v, %v; want %d", c.amount, c.rate, c.mode, got.Amount(), err, c.want)
+		}
+	}
+	if _, err := New(25, CNY).MulRat(big.NewRat(1, 10), Exact); err == nil {
+		t.Fatal("Exact should reject rounding")
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	parts, err := New(1000, CNY).Split(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parts[0].Amount() != 334 || parts[1].Amount() != 333 || parts[2].Amount() != 333 {
+		t.Fatalf("split = %v", parts)
+	}
+
+	// 按价格 3:7 分摊 -0.05 元优惠
+	parts, _ = New(-5, CNY).Allocate(3, 7)
+	if parts[0].Amount()+parts[1].Amount() != -5 {
+		t.Fatalf("allocate lost cents: %v", parts)
+	}
+
+	for _, total := range []int64{1, 7, 99, 1000003, -12345} {
+		ratios := []int64{1, 2, 3, 0, 5}
+		parts, _ := New(total, CNY).Allocate(ratios...)
+		var sum int64
+		for _, p := range parts {
+			sum += p.Amount()
+		}
+		if sum != total || parts[3].Amount() != 0 {
+			t.Fatalf("allocate %d = %v", total, parts)
+		}
+	}
+}
+
+func TestEncoding(t *testing.T) {
+	m := New(-1234, CNY)
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"amount":"-12.34","currency":"CNY"}` {
+		t.Fatalf("json = %s", b)
+	}
+	var got Money
+	if err := json.Unmarshal(b, &got); err != nil || !got.Equal(m) {
+		t.Fatalf("unmarshal = %v, %v", got, err)
+	}
+
+	v, _ := m.Value()
+	var scanned Money
+	if err := scanned.Scan([]byte(v.(string))); err != nil || !scanned.Equal(m) {
+		t.Fatalf("scan = %v, %v", scanned, err)
+	}
+
+	p, err := FromProto(m.ToProto())
+	if err != nil || !p.Equal(m) {
+		t.Fatalf("proto = %v, %v", p, err)
+	}
+}
+
+func TestEncodingZero(t *testing.T) {
+	b, err := json.Marshal(Money{})
+	if err != nil || string(b) != "null" {
+		t.Fatalf("json = %s, %v", b, err)
+	}
+	got := New(1, CNY)
+	if err := json.Unmarshal([]byte(`{"price":null}`), &struct{ Price *Money }{&got}); err != nil || !got.Equal(New(1, CNY)) {
+		t.Fatalf("unmarshal null = %v, %v", got, err)
+	}
+	var zero struct{ Price Money }
+	b, _ = json.Marshal(zero)
+	if err := json.Unmarshal(b, &zero); err != nil || zero.Price != (Money{}) {
+		t.Fatalf("round trip %s = %v, %v", b, zero.Price, err)
+	}
+
+	v, err := Money{}.Value()
+	if err != nil || v != nil {
+		t.Fatalf("value = %v, %v", v, err)
+	}
+	scanned := New(1, CNY)
+	if err := scanned.Scan(v); err != nil || scanned != (Money{}) {
+		t.Fatalf("scan = %v, %v", scanned, err)
+	}
+
+	if _, err := New(100, "").Value(); err == nil {
+		t.Error("want error for amount without currency")
+	}
+	if _, err := json.Marshal(New(100, "")); err == nil {
+		t.Error("want error for amount without currency")
+	}
+}
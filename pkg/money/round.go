@@ -0,0 +1,69 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// RoundingMode 舍入方式
+type RoundingMode int
+
+const (
+	// HalfEven 银行家舍入：四舍六入五成双，大量累加时误差不会单向累积，适用于手续费、利息
+	HalfEven RoundingMode = iota
+	// HalfUp 四舍五入（.5 远离 0 舍入），适用于面向用户的展示价格
+	HalfUp
+	// Down 向 0 截断
+	Down
+	// Exact 不允许舍入，存在零头时返回 ErrInvalidAmount
+	Exact
+)
+
+func (m RoundingMode) String() string {
+	switch m {
+	case HalfEven:
+		return "HalfEven"
+	case HalfUp:
+		return "HalfUp"
+	case Down:
+		return "Down"
+	case Exact:
+		return "Exact"
+	}
+	return fmt.Sprintf("RoundingMode(%d)", int(m))
+}
+
+// round 将有理数舍入为 int64
+func round(r *big.Rat, mode RoundingMode) (int64, error) {
+	num, den := r.Num(), r.Denom()
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() != 0 {
+		twice := new(big.Int).Abs(rem)
+		twice.Lsh(twice, 1)
+		c := twice.Cmp(den)
+
+		away := false
+		switch mode {
+		case HalfEven:
+			away = c > 0 || (c == 0 && q.Bit(0) == 1)
+		case HalfUp:
+			away = c >= 0
+		case Down:
+		case Exact:
+			return 0, fmt.Errorf("%w: %s needs rounding", ErrInvalidAmount, r.FloatString(6))
+		default:
+			return 0, fmt.Errorf("money: unknown rounding mode %v", mode)
+		}
+		if away {
+			if num.Sign() < 0 {
+				q.Sub(q, big.NewInt(1))
+			} else {
+				q.Add(q, big.NewInt(1))
+			}
+		}
+	}
+	if !q.IsInt64() {
+		return 0, ErrOverflow
+	}
+	return q.Int64(), nil
+}
@@ -0,0 +1,25 @@
+package registry
+
+import (
+	"fmt"
+
+	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
+	"github.com/hashicorp/consul/api"
+)
+
+func newConsul(c *Consul) (Registry, error) {
+	cfg := api.DefaultConfig()
+	if c.GetAddress() != "" {
+		cfg.Address = c.Address
+	}
+	if c.GetScheme() != "" {
+		cfg.Scheme = c.Scheme
+	}
+	cfg.Datacenter = c.GetDatacenter()
+	cfg.Token = c.GetToken()
+	client, err := api.NewClient(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("registry: create consul client: %w", err)
+	}
+	return consul.New(client, consul.WithHealthCheck(c.GetHealthCheck()), consul.WithHeartbeat(c.GetHeartbeat())), nil
+}
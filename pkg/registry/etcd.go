@@ -0,0 +1,36 @@
+package registry
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/contrib/registry/etcd/v2"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func newEtcd(c *Etcd) (Registry, func(), error) {
+	cfg := clientv3.Config{
+		Endpoints:   c.GetEndpoints(),
+		Username:    c.GetUsername(),
+		Password:    c.GetPassword(),
+		DialTimeout: 5 * time.Second,
+	}
+	if len(cfg.Endpoints) == 0 {
+		cfg.Endpoints = []string{"127.0.0.1:2379"}
+	}
+	if c.GetDialTimeout() != nil {
+		cfg.DialTimeout = c.DialTimeout.AsDuration()
+	}
+	client, err := clientv3.New(cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("registry: create etcd client: %w", err)
+	}
+	var opts []etcd.Option
+	if c.GetNamespace() != "" {
+		opts = append(opts, etcd.Namespace(c.Namespace))
+	}
+	if c.GetTtl() != nil {
+		opts = append(opts, etcd.RegisterTTL(c.Ttl.AsDuration()))
+	}
+	return etcd.New(client, opts...), func() { _ = client.Close() }, nil
+}
@@ -0,0 +1,59 @@
+package registry
+
+import (
+	"context"
+	"net/url"
+
+	kr "github.com/go-kratos/kratos/v2/registry"
+	nr "github.com/go-kratos/nacos/registry"
+)
+
+// kindRegistry 将实例按 endpoint 协议拆分注册为 服务名.协议，nacos 本身已按此命名，不需要拆分
+type kindRegistry struct {
+	Registry
+}
+
+func withKind(r Registry) Registry {
+	if _, ok := r.(*nr.Registry); ok {
+		return r
+	}
+	return &kindRegistry{Registry: r}
+}
+
+func (r *kindRegistry) Register(ctx context.Context, ins *kr.ServiceInstance) error {
+	for _, s := range splitKind(ins) {
+		if err := r.Registry.Register(ctx, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *kindRegistry) Deregister(ctx context.Context, ins *kr.ServiceInstance) error {
+	var res error
+	for _, s := range splitKind(ins) {
+		if err := r.Registry.Deregister(ctx, s); err != nil && res == nil {
+			res = err
+		}
+	}
+	return res
+}
+
+// splitKind 每个协议一个实例，ID 同样追加协议，避免 consul 等按 ID 去重的后端互相覆盖
+func splitKind(ins *kr.ServiceInstance) []*kr.ServiceInstance {
+	res := make([]*kr.ServiceInstance, 0, len(ins.Endpoints))
+	for _, ep := range ins.Endpoints {
+		u, err := url.Parse(ep)
+		if err != nil || u.Scheme == "" {
+			continue
+		}
+		res = append(res, &kr.ServiceInstance{
+			ID:        ins.ID + "." + u.Scheme,
+			Name:      ins.Name + "." + u.Scheme,
+			Version:   ins.Version,
+			Metadata:  ins.Metadata,
+			Endpoints: []string{ep},
+		})
+	}
+	return res
+}
@@ -0,0 +1,113 @@
+package registry
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	kr "github.com/go-kratos/kratos/v2/registry"
+)
+
+// defaultMemory backend 为 memory 时同一进程内的服务共用
+var defaultMemory = NewMemory()
+
+// Memory 进程内注册中心，用于测试与本地开发
+type Memory struct {
+	mu       sync.Mutex
+	services map[string]map[string]*kr.ServiceInstance // name -> id -> instance
+	watchers map[string]map[*memoryWatcher]struct{}
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		services: make(map[string]map[string]*kr.ServiceInstance),
+		watchers: make(map[string]map[*memoryWatcher]struct{}),
+	}
+}
+
+func (m *Memory) Register(ctx context.Context, ins *kr.ServiceInstance) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.services[ins.Name] == nil {
+		m.services[ins.Name] = make(map[string]*kr.ServiceInstance)
+	}
+	m.services[ins.Name][ins.ID] = ins
+	m.notify(ins.Name)
+	return nil
+}
+
+func (m *Memory) Deregister(ctx context.Context, ins *kr.ServiceInstance) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.services[ins.Name], ins.ID)
+	m.notify(ins.Name)
+	return nil
+}
+
+func (m *Memory) GetService(ctx context.Context, name string) ([]*kr.ServiceInstance, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.list(name), nil
+}
+
+// Watch 首次立即返回当前实例，之后在实例变化时返回
+func (m *Memory) Watch(ctx context.Context, name string) (kr.Watcher, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	w := &memoryWatcher{m: m, name: name, ctx: ctx, cancel: cancel, ch: make(chan struct{}, 1)}
+	w.ch <- struct{}{}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.watchers[name] == nil {
+		m.watchers[name] = make(map[*memoryWatcher]struct{})
+	}
+	m.watchers[name][w] = struct{}{}
+	return w, nil
+}
+
+// list 按 ID 排序，调用方持有锁
+func (m *Memory) list(name string) []*kr.ServiceInstance {
+	res := make([]*kr.ServiceInstance, 0, len(m.services[name]))
+	for _, ins := range m.services[name] {
+		res = append(res, ins)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res
+}
+
+// notify 调用方持有锁
+func (m *Memory) notify(name string) {
+	for w := range m.watchers[name] {
+		select {
+		case w.ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+type memoryWatcher struct {
+	m      *Memory
+	name   string
+	ctx    context.Context
+	cancel context.CancelFunc
+	ch     chan struct{}
+}
+
+func (w *memoryWatcher) Next() ([]*kr.ServiceInstance, error) {
+	select {
+	case <-w.ctx.Done():
+		return nil, w.ctx.Err()
+	case <-w.ch:
+	}
+	w.m.mu.Lock()
+	defer w.m.mu.Unlock()
+	return w.m.list(w.name), nil
+}
+
+func (w *memoryWatcher) Stop() error {
+	w.cancel()
+	w.m.mu.Lock()
+	defer w.m.mu.Unlock()
+	delete(w.m.watchers[w.name], w)
+	return nil
+}
@@ -0,0 +1,115 @@
+package registry
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	nr "github.com/go-kratos/nacos/registry"
+	"github.com/nacos-group/nacos-sdk-go/clients"
+	"github.com/nacos-group/nacos-sdk-go/common/constant"
+	"github.com/nacos-group/nacos-sdk-go/vo"
+)
+
+func newNacos(c *Nacos) (Registry, error) {
+	param, err := NacosClientParam(c)
+	if err != nil {
+		return nil, err
+	}
+	client, err := clients.NewNamingClient(param)
+	if err != nil {
+		return nil, fmt.Errorf("registry: create nacos naming client: %w", err)
+	}
+	opts := []nr.Option{nr.WithDefaultKind("grpc")}
+	if c.GetGroup() != "" {
+		opts = append(opts, nr.WithGroup(c.Group))
+	}
+	if c.GetCluster() != "" {
+		opts = append(opts, nr.WithCluster(c.Cluster))
+	}
+	if c.GetWeight() > 0 {
+		opts = append(opts, nr.WithWeight(c.Weight))
+	}
+	return nr.New(client, opts...), nil
+}
+
+// NacosClientParam nacos 客户端参数，注册中心与配置中心共用；c 为 nil 时连接 127.0.0.1:8848
+func NacosClientParam(c *Nacos) (vo.NacosClientParam, error) {
+	var sc []constant.ServerConfig
+	if c.GetAddress() != "" {
+		sc = append(sc, nacosServer(c, c.Address, uint64(c.GetPort())))
+	}
+	for _, ep := range c.GetEndpoints() {
+		host, port, err := net.SplitHostPort(ep)
+		if err != nil {
+			return vo.NacosClientParam{}, fmt.Errorf("registry: invalid nacos endpoint %q: %w", ep, err)
+		}
+		p, err := strconv.ParseUint(port, 10, 64)
+		if err != nil {
+			return vo.NacosClientParam{}, fmt.Errorf("registry: invalid nacos endpoint %q: %w", ep, err)
+		}
+		sc = append(sc, nacosServer(c, host, p))
+	}
+	if len(sc) == 0 {
+		sc = append(sc, nacosServer(c, "127.0.0.1", 8848))
+	}
+
+	cc := &constant.ClientConfig{
+		NamespaceId:          c.GetNamespaceId(),
+		AppName:              c.GetAppName(),
+		TimeoutMs:            5000,
+		BeatInterval:         5000,
+		NotLoadCacheAtStart:  !c.GetLoadCacheAtStart(),
+		UpdateCacheWhenEmpty: c.GetUpdateCacheWhenEmpty(),
+		Username:             c.GetUsername(),
+		Password:             c.GetPassword(),
+		AccessKey:            c.GetAccessKey(),
+		SecretKey:            c.GetSecretKey(),
+		LogDir:               filepath.Join(os.TempDir(), "nacos", "log"),
+		CacheDir:             filepath.Join(os.TempDir(), "nacos", "cache"),
+		LogLevel:             "info",
+		RotateTime:           "1h",
+		MaxAge:               3,
+	}
+	if cc.NamespaceId == "" {
+		cc.NamespaceId = "public"
+	}
+	if c.GetTimeout() != nil {
+		cc.TimeoutMs = uint64(c.Timeout.AsDuration().Milliseconds())
+	}
+	if c.GetBeatInterval() != nil {
+		cc.BeatInterval = c.BeatInterval.AsDuration().Milliseconds()
+	}
+	if c.GetLogDir() != "" {
+		cc.LogDir = c.LogDir
+	}
+	if c.GetCacheDir() != "" {
+		cc.CacheDir = c.CacheDir
+	}
+	if c.GetLogLevel() != "" {
+		cc.LogLevel = c.LogLevel
+	}
+	if c.GetRotateTime() != "" {
+		cc.RotateTime = c.RotateTime
+	}
+	if c.GetMaxAge() > 0 {
+		cc.MaxAge = c.MaxAge
+	}
+	return vo.NacosClientParam{ClientConfig: cc, ServerConfigs: sc}, nil
+}
+
+func nacosServer(c *Nacos, host string, port uint64) constant.ServerConfig {
+	if port == 0 {
+		port = 8848
+	}
+	s := constant.ServerConfig{IpAddr: host, Port: port, Scheme: "http", ContextPath: "/nacos"}
+	if c.GetScheme() != "" {
+		s.Scheme = c.Scheme
+	}
+	if c.GetContextPath() != "" {
+		s.ContextPath = c.ContextPath
+	}
+	return s
+}
@@ -0,0 +1,108 @@
+/*
+ * 服务注册与发现，按配置选择后端：nacos、etcd、consul、static（固定列表）与 memory（进程内，用于测试）。
+ * 各服务的 conf.Registry / conf.Discovery 引用本包的配置消息，生成的 Get 方法即实现 Config。
+ *
+ * 所有后端都按 endpoint 协议将实例注册为 服务名.协议（与 nacos 一致），
+ * 客户端统一使用 discovery:///casso.user.service.grpc
+ */
+package registry
+
+import (
+	"errors"
+	"fmt"
+
+	kr "github.com/go-kratos/kratos/v2/registry"
+)
+
+// 注册中心后端
+const (
+	BackendNacos  = "nacos"
+	BackendEtcd   = "etcd"
+	BackendConsul = "consul"
+	BackendStatic = "static"
+	BackendMemory = "memory"
+)
+
+// Config 注册中心配置，backend 为空时使用 nacos
+type Config interface {
+	GetBackend() string
+	GetNacos() *Nacos
+	GetEtcd() *Etcd
+	GetConsul() *Consul
+	GetStatic() *Static
+}
+
+// Registry 同时支持注册与发现
+type Registry interface {
+	kr.Registrar
+	kr.Discovery
+}
+
+// New 按配置创建注册中心，返回的 cleanup 关闭底层客户端
+func New(c Config) (Registry, func(), error) {
+	if c == nil {
+		return nil, nil, errors.New("registry: nil config")
+	}
+	var (
+		r       Registry
+		cleanup = func() {}
+		err     error
+	)
+	switch backend(c) {
+	case BackendNacos:
+		r, err = newNacos(c.GetNacos())
+	case BackendEtcd:
+		r, cleanup, err = newEtcd(c.GetEtcd())
+	case BackendConsul:
+		r, err = newConsul(c.GetConsul())
+	case BackendStatic:
+		r, err = NewStatic(c.GetStatic())
+	case BackendMemory:
+		r = defaultMemory
+	default:
+		err = fmt.Errorf("registry: unknown backend %q", c.GetBackend())
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return withKind(r), cleanup, nil
+}
+
+// NewRegistrar 服务注册
+func NewRegistrar(c Config) (kr.Registrar, func(), error) {
+	return New(c)
+}
+
+// NewDiscovery 服务发现
+func NewDiscovery(c Config) (kr.Discovery, func(), error) {
+	return New(c)
+}
+
+func backend(c Config) string {
+	if b := c.GetBackend(); b != "" {
+		return b
+	}
+	return BackendNacos
+}
+
+// Validate 校验 backend 与对应后端的配置，由 pkg/config 加载配置时调用
+func Validate(c Config) error {
+	var missing bool
+	switch backend(c) {
+	case BackendNacos:
+		missing = c.GetNacos() == nil
+	case BackendEtcd:
+		missing = c.GetEtcd() == nil
+	case BackendConsul:
+		missing = c.GetConsul() == nil
+	case BackendStatic:
+		missing = c.GetStatic() == nil
+	case BackendMemory:
+	default:
+		return fmt.Errorf("registry: unknown backend %q", c.GetBackend())
+	}
+	if missing {
+		return fmt.Errorf("registry: %s config is required", backend(c))
+	}
+	return nil
+}
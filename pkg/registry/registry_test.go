@@ -0,0 +1,92 @@
+package registry
+
+import (
+	"context"
+	"testing"
+
+	kr "github.com/go-kratos/kratos/v2/registry"
+)
+
+type testConfig struct {
+	backend string
+	static  *Static
+}
+
+func (c *testConfig) GetBackend() string { return c.backend }
+func (c *testConfig) GetNacos() *Nacos   { return nil }
+func (c *testConfig) GetEtcd() *Etcd     { return nil }
+func (c *testConfig) GetConsul() *Consul { return nil }
+func (c *testConfig) GetStatic() *Static { return c.static }
+
+func TestMemory(t *testing.T) {
+	r, cleanup, err := New(&testConfig{backend: BackendMemory})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	ctx := context.Background()
+	w, err := r.Watch(ctx, "casso.user.service.grpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+	if res, _ := w.Next(); len(res) != 0 {
+		t.Fatalf("initial instances = %v", res)
+	}
+
+	ins := &kr.ServiceInstance{ID: "1", Name: "casso.user.service", Endpoints: []string{"grpc://127.0.0.1:9000", "http://127.0.0.1:8000"}}
+	if err := r.Register(ctx, ins); err != nil {
+		t.Fatal(err)
+	}
+	res, _ := w.Next()
+	if len(res) != 1 || res[0].Endpoints[0] != "grpc://127.0.0.1:9000" || res[0].ID != "1.grpc" {
+		t.Fatalf("instances = %+v", res)
+	}
+	if res, _ := r.GetService(ctx, "casso.user.service.http"); len(res) != 1 {
+		t.Fatalf("http instances = %+v", res)
+	}
+
+	if err := r.Deregister(ctx, ins); err != nil {
+		t.Fatal(err)
+	}
+	if res, _ := w.Next(); len(res) != 0 {
+		t.Fatalf("instances after deregister = %+v", res)
+	}
+}
+
+func TestStatic(t *testing.T) {
+	r, _, err := New(&testConfig{backend: BackendStatic, static: &Static{Services: map[string]*Static_Service{
+		"casso.user.service.grpc": {Endpoints: []string{"127.0.0.1:9000", "grpc://127.0.0.1:9001"}},
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, _ := r.GetService(context.Background(), "casso.user.service.grpc")
+	if len(res) != 2 || res[0].Endpoints[0] != "grpc://127.0.0.1:9000" {
+		t.Fatalf("instances = %+v", res)
+	}
+}
+
+func TestUnknownBackend(t *testing.T) {
+	if _, _, err := New(&testConfig{backend: "zookeeper"}); err == nil {
+		t.Fatal("unknown backend accepted")
+	}
+}
+
+func TestNacosClientParam(t *testing.T) {
+	p, err := NacosClientParam(&Nacos{Endpoints: []string{"10.0.0.1:8848", "10.0.0.2:8848"}, NamespaceId: "dev"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.ServerConfigs) != 2 || p.ClientConfig.NamespaceId != "dev" || p.ClientConfig.LogLevel != "info" {
+		t.Fatalf("param = %+v", p)
+	}
+	if _, err := NacosClientParam(&Nacos{Endpoints: []string{"10.0.0.1"}}); err == nil {
+		t.Fatal("invalid endpoint accepted")
+	}
+	// 未配置时连接本机
+	if p, _ := NacosClientParam(nil); len(p.ServerConfigs) != 1 || p.ServerConfigs[0].IpAddr != "127.0.0.1" {
+		t.Fatalf("default param = %+v", p)
+	}
+}
@@ -0,0 +1,71 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	kr "github.com/go-kratos/kratos/v2/registry"
+)
+
+// StaticRegistry 固定的服务列表，注册与注销不做任何事
+type StaticRegistry struct {
+	services map[string][]*kr.ServiceInstance
+}
+
+// NewStatic 省略协议的 endpoint 按 grpc 处理
+func NewStatic(c *Static) (*StaticRegistry, error) {
+	r := &StaticRegistry{services: make(map[string][]*kr.ServiceInstance, len(c.GetServices()))}
+	for name, svc := range c.GetServices() {
+		for _, ep := range svc.GetEndpoints() {
+			if !strings.Contains(ep, "://") {
+				ep = "grpc://" + ep
+			}
+			if _, err := url.Parse(ep); err != nil {
+				return nil, fmt.Errorf("registry: invalid endpoint %q of %s: %w", ep, name, err)
+			}
+			r.services[name] = append(r.services[name], &kr.ServiceInstance{ID: ep, Name: name, Endpoints: []string{ep}})
+		}
+	}
+	return r, nil
+}
+
+func (r *StaticRegistry) Register(ctx context.Context, ins *kr.ServiceInstance) error {
+	return nil
+}
+
+func (r *StaticRegistry) Deregister(ctx context.Context, ins *kr.ServiceInstance) error {
+	return nil
+}
+
+func (r *StaticRegistry) GetService(ctx context.Context, name string) ([]*kr.ServiceInstance, error) {
+	return r.services[name], nil
+}
+
+// Watch 首次返回全部实例，之后阻塞到 Stop 或 ctx 结束
+func (r *StaticRegistry) Watch(ctx context.Context, name string) (kr.Watcher, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	return &staticWatcher{ctx: ctx, cancel: cancel, instances: r.services[name]}, nil
+}
+
+type staticWatcher struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	instances []*kr.ServiceInstance
+	sent      bool
+}
+
+func (w *staticWatcher) Next() ([]*kr.ServiceInstance, error) {
+	if !w.sent {
+		w.sent = true
+		return w.instances, nil
+	}
+	<-w.ctx.Done()
+	return nil, w.ctx.Err()
+}
+
+func (w *staticWatcher) Stop() error {
+	w.cancel()
+	return nil
+}
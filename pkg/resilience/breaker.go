@@ -0,0 +1,89 @@
+package resilience
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/aegis/circuitbreaker"
+	"github.com/go-kratos/aegis/circuitbreaker/sre"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+)
+
+// BreakerReason 熔断拒绝请求时返回的错误原因
+const BreakerReason = "CIRCUIT_BREAKER_OPEN"
+
+// IsBreakerOpen 是否为熔断拒绝的请求
+func IsBreakerOpen(err error) bool {
+	return errors.Reason(err) == BreakerReason
+}
+
+type breakerOptions struct {
+	sre []sre.Option
+}
+
+// BreakerOption SRE 熔断配置，未设置或取值不大于 0 时使用 aegis 的默认值
+type BreakerOption func(*breakerOptions)
+
+// WithSuccess 成功率阈值，成功率低于此值时按比例拒绝请求
+func WithSuccess(s float64) BreakerOption {
+	return func(o *breakerOptions) {
+		if s > 0 {
+			o.sre = append(o.sre, sre.WithSuccess(s))
+		}
+	}
+}
+
+// WithRequest 统计窗口内请求数少于 n 时不熔断
+func WithRequest(n int64) BreakerOption {
+	return func(o *breakerOptions) {
+		if n > 0 {
+			o.sre = append(o.sre, sre.WithRequest(n))
+		}
+	}
+}
+
+// WithWindow 统计窗口与分桶数
+func WithWindow(d time.Duration, bucket int) BreakerOption {
+	return func(o *breakerOptions) {
+		if d > 0 {
+			o.sre = append(o.sre, sre.WithWindow(d))
+		}
+		if bucket > 0 {
+			o.sre = append(o.sre, sre.WithBucket(bucket))
+		}
+	}
+}
+
+// Breaker SRE 自适应熔断，每个方法单独统计；超时与 5xx 计为失败，拒绝时返回 503 BreakerReason
+func Breaker(opts ...BreakerOption) middleware.Middleware {
+	o := &breakerOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	var breakers sync.Map
+	get := func(op string) circuitbreaker.CircuitBreaker {
+		if b, ok := breakers.Load(op); ok {
+			return b.(circuitbreaker.CircuitBreaker)
+		}
+		b, _ := breakers.LoadOrStore(op, sre.NewBreaker(o.sre...))
+		return b.(circuitbreaker.CircuitBreaker)
+	}
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			op := operation(ctx)
+			b := get(op)
+			if err := b.Allow(); err != nil {
+				return nil, errors.ServiceUnavailable(BreakerReason, "circuit breaker is open for "+op).WithCause(err)
+			}
+			reply, err := handler(ctx, req)
+			if serverError(err) {
+				b.MarkFailed()
+			} else {
+				b.MarkSuccess()
+			}
+			return reply, err
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package resilience
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"google.golang.org/protobuf/proto"
+)
+
+// Degrader 降级策略：Save 保存成功的结果，Load 在下游不可用时返回降级结果
+type Degrader interface {
+	Save(op string, req, reply interface{})
+	Load(op string, req interface{}) (interface{}, bool)
+}
+
+// Fallback 下游不可用（超时、503、504、熔断）时由 d 返回降级结果，没有降级结果时返回原错误；
+// 只对 methods 生效，适用于允许短暂返回旧数据的读接口
+func Fallback(d Degrader, methods ...string) middleware.Middleware {
+	set := newMethodSet(methods)
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			op := operation(ctx)
+			if !set.has(op) {
+				return handler(ctx, req)
+			}
+			reply, err := handler(ctx, req)
+			if err == nil {
+				d.Save(op, req, reply)
+				return reply, nil
+			}
+			if unavailable(err) {
+				if res, ok := d.Load(op, req); ok {
+					return res, nil
+				}
+			}
+			return reply, err
+		}
+	}
+}
+
+type staleItem struct {
+	reply    proto.Message
+	expireAt time.Time
+}
+
+// StaleCache 在内存中缓存最近成功的结果，请求与结果需为 proto.Message；超过 size 时淘汰过期与最早写入的结果
+type StaleCache struct {
+	ttl  time.Duration
+	size int
+
+	mu    sync.Mutex
+	items map[string]staleItem
+	keys  []string // 写入顺序
+}
+
+// NewStaleCache 结果最多保留 ttl，最多缓存 size 个；取值不大于 0 时分别为 1 分钟与 10000
+func NewStaleCache(ttl time.Duration, size int) *StaleCache {
+	if ttl <= 0 {
+		ttl = time.Minute
+	}
+	if size <= 0 {
+		size = 10000
+	}
+	return &StaleCache{ttl: ttl, size: size, items: make(map[string]staleItem)}
+}
+
+func cacheKey(op string, req interface{}) (string, bool) {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return "", false
+	}
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return "", false
+	}
+	return op + "\x00" + string(b), true
+}
+
+func (c *StaleCache) Save(op string, req, reply interface{}) {
+	m, ok := reply.(proto.Message)
+	if !ok {
+		return
+	}
+	key, ok := cacheKey(op, req)
+	if !ok {
+		return
+	}
+	item := staleItem{reply: proto.Clone(m), expireAt: time.Now().Add(c.ttl)}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.items[key]; !ok {
+		c.keys = append(c.keys, key)
+	}
+	c.items[key] = item
+	if len(c.items) > c.size {
+		c.evict()
+	}
+}
+
+// evict 先淘汰过期的结果，仍然超出时淘汰最早写入的
+func (c *StaleCache) evict() {
+	now := time.Now()
+	keys := c.keys[:0]
+	for _, k := range c.keys {
+		if now.Before(c.items[k].expireAt) {
+			keys = append(keys, k)
+		} else {
+			delete(c.items, k)
+		}
+	}
+	for len(keys) > c.size {
+		delete(c.items, keys[0])
+		keys = keys[1:]
+	}
+	c.keys = append([]string(nil), keys...)
+}
+
+func (c *StaleCache) Load(op string, req interface{}) (interface{}, bool) {
+	key, ok := cacheKey(op, req)
+	if !ok {
+		return nil, false
+	}
+	c.mu.Lock()
+	item, ok := c.items[key]
+	c.mu.Unlock()
+	if !ok || time.Now().After(item.expireAt) {
+		return nil, false
+	}
+	return proto.Clone(item.reply), true
+}
@@ -0,0 +1,74 @@
+/*
+ * 下游服务客户端的容错中间件：超时、重试、熔断与降级。
+ * 按从外到内的顺序使用：
+ *
+ *	grpc.WithMiddleware(
+ *		resilience.Fallback(resilience.NewStaleCache(time.Minute, 10000), "GetUser"), // 失败时返回缓存的上一次结果
+ *		resilience.Retry(resilience.WithMethods("GetUser")), // 只重试幂等方法
+ *		resilience.Breaker(), // 按方法统计熔断
+ *		resilience.Timeout(200*time.Millisecond, nil), // 每次尝试单独计时
+ *	)
+ */
+package resilience
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// operation 调用的方法，如 /api.user.service.v1.User/GetUser
+func operation(ctx context.Context) string {
+	if tr, ok := transport.FromClientContext(ctx); ok {
+		return tr.Operation()
+	}
+	return ""
+}
+
+// methodSet 方法集合，可以写完整 operation 或只写方法名
+type methodSet map[string]struct{}
+
+func newMethodSet(methods []string) methodSet {
+	s := make(methodSet, len(methods))
+	for _, m := range methods {
+		s[m] = struct{}{}
+	}
+	return s
+}
+
+func (s methodSet) has(op string) bool {
+	if _, ok := s[op]; ok {
+		return true
+	}
+	_, ok := s[methodName(op)]
+	return ok
+}
+
+func methodName(op string) string {
+	return op[strings.LastIndex(op, "/")+1:]
+}
+
+// unavailable 下游不可用：超时、503、504 与熔断，这类错误可以重试或降级
+func unavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	switch errors.Code(err) {
+	case 503, 504:
+		return true
+	}
+	return false
+}
+
+// serverError 计入熔断的失败：下游不可用或 5xx；4xx 与调用方取消属于调用方的问题，不计入
+func serverError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	return unavailable(err) || errors.Code(err) >= 500
+}
@@ -0,0 +1,116 @@
+package resilience
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type testTransport struct {
+	op string
+}
+
+func (t *testTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (t *testTransport) Endpoint() string                { return "" }
+func (t *testTransport) Operation() string               { return t.op }
+func (t *testTransport) RequestHeader() transport.Header { return nil }
+func (t *testTransport) ReplyHeader() transport.Header   { return nil }
+
+func clientContext(op string) context.Context {
+	return transport.NewClientContext(context.Background(), &testTransport{op: op})
+}
+
+var errUnavailable = errors.ServiceUnavailable("UNAVAILABLE", "no available node")
+
+func TestTimeout(t *testing.T) {
+	m := Timeout(time.Second, map[string]time.Duration{"GetUser": 10 * time.Millisecond})
+	var got time.Duration
+	h := m(func(ctx context.Context, req interface{}) (interface{}, error) {
+		deadline, _ := ctx.Deadline()
+		got = time.Until(deadline)
+		return nil, nil
+	})
+	_, _ = h(clientContext("/api.user.service.v1.User/GetUser"), nil)
+	if got > 10*time.Millisecond {
+		t.Fatalf("GetUser timeout = %v", got)
+	}
+	_, _ = h(clientContext("/api.user.service.v1.User/CreateUser"), nil)
+	if got <= 10*time.Millisecond || got > time.Second {
+		t.Fatalf("CreateUser timeout = %v", got)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	m := Retry(WithAttempts(3), WithBackoff(time.Millisecond, time.Millisecond), WithMethods("GetUser"))
+	calls := 0
+	h := m(func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return nil, errUnavailable
+	})
+	if _, err := h(clientContext("/api.user.service.v1.User/GetUser"), nil); err == nil || calls != 3 {
+		t.Fatalf("GetUser calls = %d, err = %v", calls, err)
+	}
+	// 非幂等方法不重试
+	calls = 0
+	if _, _ = h(clientContext("/api.user.service.v1.User/CreateUser"), nil); calls != 1 {
+		t.Fatalf("CreateUser calls = %d", calls)
+	}
+	// 业务错误不重试
+	calls = 0
+	h = m(func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return nil, errors.NotFound("USER_NOT_FOUND", "")
+	})
+	if _, _ = h(clientContext("/api.user.service.v1.User/GetUser"), nil); calls != 1 {
+		t.Fatalf("not found calls = %d", calls)
+	}
+}
+
+func TestBreaker(t *testing.T) {
+	m := Breaker(WithRequest(10), WithSuccess(0.9))
+	var h middleware.Handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, errUnavailable
+	}
+	h = m(h)
+	ctx := clientContext("/api.user.service.v1.User/GetUser")
+	open := false
+	for i := 0; i < 200 && !open; i++ {
+		_, err := h(ctx, nil)
+		open = IsBreakerOpen(err)
+	}
+	if !open {
+		t.Fatal("breaker not open")
+	}
+	// 其他方法单独统计
+	if _, err := h(clientContext("/api.user.service.v1.User/ListUser"), nil); IsBreakerOpen(err) {
+		t.Fatal("breaker shared between methods")
+	}
+}
+
+func TestFallback(t *testing.T) {
+	cache := NewStaleCache(time.Minute, 1)
+	m := Fallback(cache, "GetUser")
+	fail := false
+	h := m(func(ctx context.Context, req interface{}) (interface{}, error) {
+		if fail {
+			return nil, errUnavailable
+		}
+		return wrapperspb.String("user " + req.(*wrapperspb.Int64Value).String()), nil
+	})
+	ctx := clientContext("/api.user.service.v1.User/GetUser")
+	want, _ := h(ctx, wrapperspb.Int64(1))
+
+	fail = true
+	got, err := h(ctx, wrapperspb.Int64(1))
+	if err != nil || got.(*wrapperspb.StringValue).Value != want.(*wrapperspb.StringValue).Value {
+		t.Fatalf("fallback = %v, %v", got, err)
+	}
+	if _, err := h(ctx, wrapperspb.Int64(2)); err == nil {
+		t.Fatal("fallback without cached reply")
+	}
+}
@@ -0,0 +1,86 @@
+package resilience
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+)
+
+type retryOptions struct {
+	attempts   int
+	backoff    time.Duration
+	maxBackoff time.Duration
+	methods    methodSet
+}
+
+// RetryOption 重试配置
+type RetryOption func(*retryOptions)
+
+// WithAttempts 最大尝试次数（含首次），默认 3
+func WithAttempts(n int) RetryOption {
+	return func(o *retryOptions) {
+		o.attempts = n
+	}
+}
+
+// WithBackoff 首次重试前等待 d，之后按 2 倍递增且不超过 max；实际等待时间在 [d/2, d) 内随机，
+// 取值不大于 0 时使用默认值 20ms 与 200ms
+func WithBackoff(d, max time.Duration) RetryOption {
+	return func(o *retryOptions) {
+		if d > 0 {
+			o.backoff = d
+		}
+		if max > 0 {
+			o.maxBackoff = max
+		}
+	}
+}
+
+// WithMethods 允许重试的幂等方法，未配置时不重试任何方法
+func WithMethods(methods ...string) RetryOption {
+	return func(o *retryOptions) {
+		o.methods = newMethodSet(methods)
+	}
+}
+
+// Retry 幂等方法在下游不可用（超时、503、504）时按指数退避重试，熔断拒绝的请求不重试；
+// 等待期间 ctx 结束时返回最后一次的错误
+func Retry(opts ...RetryOption) middleware.Middleware {
+	o := &retryOptions{attempts: 3, backoff: 20 * time.Millisecond, maxBackoff: 200 * time.Millisecond}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if o.attempts <= 1 || !o.methods.has(operation(ctx)) {
+				return handler(ctx, req)
+			}
+			backoff := o.backoff
+			for i := 1; ; i++ {
+				reply, err := handler(ctx, req)
+				if i >= o.attempts || !unavailable(err) || IsBreakerOpen(err) || ctx.Err() != nil {
+					return reply, err
+				}
+				timer := time.NewTimer(jitter(backoff))
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return reply, err
+				case <-timer.C:
+				}
+				if backoff *= 2; backoff > o.maxBackoff {
+					backoff = o.maxBackoff
+				}
+			}
+		}
+	}
+}
+
+func jitter(d time.Duration) time.Duration {
+	if d <= 1 {
+		return d
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)))
+}
@@ -0,0 +1,36 @@
+package resilience
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+)
+
+// Timeout 单次调用超时，methods 按方法覆盖 d；ctx 已有更早的截止时间时不变，d 不大于 0 时不限制
+func Timeout(d time.Duration, methods map[string]time.Duration) middleware.Middleware {
+	timeouts := make(map[string]time.Duration, len(methods))
+	for m, t := range methods {
+		timeouts[m] = t
+	}
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			op := operation(ctx)
+			t, ok := timeouts[op]
+			if !ok {
+				if t, ok = timeouts[methodName(op)]; !ok {
+					t = d
+				}
+			}
+			if t <= 0 {
+				return handler(ctx, req)
+			}
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= t {
+				return handler(ctx, req)
+			}
+			ctx, cancel := context.WithTimeout(ctx, t)
+			defer cancel()
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package tracing
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const gormSpanKey = "tracing:span"
+
+// GORM 为每条 SQL 创建子 span，父 span 取自 db.WithContext(ctx) 的 ctx；name 区分主从库，如 primary、replica-0
+func GORM(db *gorm.DB, tp trace.TracerProvider, name string) error {
+	tracer := tp.Tracer(instrumentationName)
+
+	before := func(operation string) func(*gorm.DB) {
+		return func(db *gorm.DB) {
+			ctx := db.Statement.Context
+			if ctx == nil || !trace.SpanFromContext(ctx).SpanContext().IsValid() {
+				// 没有上游 span 的 SQL（如定时任务、迁移）不单独成为一条链路
+				return
+			}
+			ctx, span := tracer.Start(ctx, "gorm."+operation, trace.WithSpanKind(trace.SpanKindClient))
+			span.SetAttributes(
+				semconv.DBSystemMySQL,
+				semconv.DBOperationKey.String(operation),
+				attribute.String("db.instance", name),
+			)
+			db.Statement.Context = ctx
+			db.InstanceSet(gormSpanKey, span)
+		}
+	}
+	after := func(db *gorm.DB) {
+		v, ok := db.InstanceGet(gormSpanKey)
+		if !ok {
+			return
+		}
+		span, ok := v.(trace.Span)
+		if !ok {
+			return
+		}
+		defer span.End()
+		span.SetAttributes(
+			semconv.DBStatementKey.String(db.Statement.SQL.String()),
+			semconv.DBSQLTableKey.String(db.Statement.Table),
+			attribute.Int64("db.rows_affected", db.RowsAffected),
+		)
+		if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			span.RecordError(db.Error)
+			span.SetStatus(codes.Error, db.Error.Error())
+		}
+	}
+
+	cb := db.Callback()
+	for _, err := range []error{
+		cb.Create().Before("gorm:create").Register("tracing:before_create", before("create")),
+		cb.Create().After("gorm:create").Register("tracing:after_create", after),
+		cb.Query().Before("gorm:query").Register("tracing:before_query", before("query")),
+		cb.Query().After("gorm:query").Register("tracing:after_query", after),
+		cb.Update().Before("gorm:update").Register("tracing:before_update", before("update")),
+		cb.Update().After("gorm:update").Register("tracing:after_update", after),
+		cb.Delete().Before("gorm:delete").Register("tracing:before_delete", before("delete")),
+		cb.Delete().After("gorm:delete").Register("tracing:after_delete", after),
+		cb.Row().Before("gorm:row").Register("tracing:before_row", before("row")),
+		cb.Row().After("gorm:row").Register("tracing:after_row", after),
+		cb.Raw().Before("gorm:raw").Register("tracing:before_raw", before("raw")),
+		cb.Raw().After("gorm:raw").Register("tracing:after_raw", after),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
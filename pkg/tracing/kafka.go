@@ -0,0 +1,40 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Producer 发送 Kafka 消息前调用：创建 producer span，并把 traceparent、baggage 写入消息头；
+// carrier 由调用方适配具体客户端的消息头，如 sarama 的 []RecordHeader。发送完成后结束 span
+func Producer(ctx context.Context, tp trace.TracerProvider, topic string, carrier propagation.TextMapCarrier) (context.Context, trace.Span) {
+	ctx, span := tp.Tracer(instrumentationName).Start(ctx, topic+" send",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKey.String("kafka"),
+			semconv.MessagingDestinationKindTopic,
+			semconv.MessagingDestinationKey.String(topic),
+		),
+	)
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	return ctx, span
+}
+
+// Consumer 处理 Kafka 消息前调用：从消息头恢复上游的链路与 baggage，创建 consumer span，处理完成后结束 span
+func Consumer(ctx context.Context, tp trace.TracerProvider, topic, group string, carrier propagation.TextMapCarrier) (context.Context, trace.Span) {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, carrier)
+	return tp.Tracer(instrumentationName).Start(ctx, topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKey.String("kafka"),
+			semconv.MessagingDestinationKindTopic,
+			semconv.MessagingDestinationKey.String(topic),
+			semconv.MessagingOperationProcess,
+			semconv.MessagingKafkaConsumerGroupKey.String(group),
+		),
+	)
+}
@@ -0,0 +1,95 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/go-redis/redis/v8"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Redis 为每条命令（pipeline 为一个 span）创建子 span，没有上游 span 的命令不记录
+func Redis(rd *redis.Client, tp trace.TracerProvider) {
+	rd.AddHook(&redisHook{tracer: tp.Tracer(instrumentationName), addr: rd.Options().Addr})
+}
+
+type redisHook struct {
+	tracer trace.Tracer
+	addr   string
+}
+
+func (h *redisHook) start(ctx context.Context, name, statement string) context.Context {
+	if !trace.SpanFromContext(ctx).SpanContext().IsValid() {
+		return ctx
+	}
+	ctx, _ = h.tracer.Start(ctx, "redis."+name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemRedis,
+			semconv.DBOperationKey.String(name),
+			semconv.DBStatementKey.String(statement),
+			attribute.String("net.peer.name", h.addr),
+		),
+	)
+	return ctx
+}
+
+func (h *redisHook) end(ctx context.Context, err error) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+	if err != nil && !errors.Is(err, redis.Nil) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func (h *redisHook) BeforeProcess(ctx context.Context, cmd redis.Cmder) (context.Context, error) {
+	return h.start(ctx, cmd.Name(), statement(cmd)), nil
+}
+
+func (h *redisHook) AfterProcess(ctx context.Context, cmd redis.Cmder) error {
+	h.end(ctx, cmd.Err())
+	return nil
+}
+
+func (h *redisHook) BeforeProcessPipeline(ctx context.Context, cmds []redis.Cmder) (context.Context, error) {
+	names := make([]string, 0, len(cmds))
+	for _, cmd := range cmds {
+		names = append(names, cmd.Name())
+	}
+	return h.start(ctx, "pipeline", strings.Join(names, " ")), nil
+}
+
+func (h *redisHook) AfterProcessPipeline(ctx context.Context, cmds []redis.Cmder) error {
+	var err error
+	for _, cmd := range cmds {
+		if cmd.Err() != nil {
+			err = cmd.Err()
+			break
+		}
+	}
+	h.end(ctx, err)
+	return nil
+}
+
+// statement 只记录命令与 key，不记录写入的值
+func statement(cmd redis.Cmder) string {
+	args := cmd.Args()
+	if len(args) > 2 {
+		args = args[:2]
+	}
+	parts := make([]string, 0, len(args))
+	for _, a := range args {
+		if s, ok := a.(string); ok {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, " ")
+}
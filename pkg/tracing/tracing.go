@@ -0,0 +1,134 @@
+/*
+ * 链路追踪：按 Config 选择 OTLP gRPC/HTTP、stdout 上报或关闭，
+ * 采样使用 ParentBased(TraceIDRatioBased)，跨服务通过 W3C traceparent 与 baggage 传递
+ *
+ * GORM、Redis 的 span 分别由 GORM、Redis 注册，Kafka 消息通过 Producer、Consumer 在消息头中传递上下文
+ */
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+)
+
+// 上报方式
+const (
+	ExporterOTLPGRPC = "otlp-grpc" // 默认
+	ExporterOTLPHTTP = "otlp-http"
+	ExporterStdout   = "stdout" // 输出到标准输出，本地没有 collector 时使用
+	ExporterNone     = "none"   // 不上报也不采样，仍然透传上游的 traceparent
+)
+
+const instrumentationName = "casso/pkg/tracing"
+
+// NewTracerProvider 按配置创建 TracerProvider，并设置为全局的 TracerProvider 与 W3C trace context、baggage 传播；
+// 返回的 cleanup 在退出时上报剩余的 span。name、version 为 main.Name、main.Version
+func NewTracerProvider(c *Config, name, version string) (*tracesdk.TracerProvider, func(), error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	opts := []tracesdk.TracerProviderOption{
+		tracesdk.WithResource(resource.NewSchemaless(
+			semconv.ServiceNameKey.String(name),
+			semconv.ServiceVersionKey.String(version),
+		)),
+	}
+	if c.GetExporter() == ExporterNone {
+		opts = append(opts, tracesdk.WithSampler(tracesdk.NeverSample()))
+	} else {
+		exp, err := newExporter(c)
+		if err != nil {
+			return nil, nil, err
+		}
+		ratio := 1.0
+		if c.GetSampleRatio() != nil {
+			ratio = c.GetSampleRatio().GetValue()
+		}
+		opts = append(opts,
+			tracesdk.WithSampler(tracesdk.ParentBased(tracesdk.TraceIDRatioBased(ratio))),
+			tracesdk.WithBatcher(exp),
+		)
+	}
+
+	tp := tracesdk.NewTracerProvider(opts...)
+	otel.SetTracerProvider(tp)
+	return tp, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = tp.Shutdown(ctx)
+	}, nil
+}
+
+// Validate 校验上报方式与采样率，由 pkg/config 加载配置时调用
+func (x *Config) Validate() error {
+	switch x.GetExporter() {
+	case "", ExporterOTLPGRPC, ExporterOTLPHTTP, ExporterStdout, ExporterNone:
+	default:
+		return fmt.Errorf("tracing: unknown exporter %q", x.GetExporter())
+	}
+	if r := x.GetSampleRatio(); r != nil && (r.GetValue() < 0 || r.GetValue() > 1) {
+		return fmt.Errorf("tracing: sample_ratio %v out of [0, 1]", r.GetValue())
+	}
+	return nil
+}
+
+func newExporter(c *Config) (tracesdk.SpanExporter, error) {
+	switch c.GetExporter() {
+	case "", ExporterOTLPGRPC:
+		opts := []otlptracegrpc.Option{}
+		if c.GetEndpoint() != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(c.Endpoint))
+		}
+		if c.GetInsecure() {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		if len(c.GetHeaders()) > 0 {
+			opts = append(opts, otlptracegrpc.WithHeaders(c.Headers))
+		}
+		if c.GetTimeout() != nil {
+			opts = append(opts, otlptracegrpc.WithTimeout(c.Timeout.AsDuration()))
+		}
+		// 不阻塞等待连接，collector 不可用时服务仍可启动
+		return otlptrace.New(context.Background(), otlptracegrpc.NewClient(opts...))
+	case ExporterOTLPHTTP:
+		opts := []otlptracehttp.Option{}
+		insecure := c.GetInsecure()
+		if endpoint := c.GetEndpoint(); endpoint != "" {
+			// 兼容 http://host:port/path 形式的地址
+			if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+				endpoint = u.Host
+				if u.Path != "" && u.Path != "/" {
+					opts = append(opts, otlptracehttp.WithURLPath(u.Path))
+				}
+				insecure = insecure || u.Scheme == "http"
+			}
+			opts = append(opts, otlptracehttp.WithEndpoint(endpoint))
+		}
+		if insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		if len(c.GetHeaders()) > 0 {
+			opts = append(opts, otlptracehttp.WithHeaders(c.Headers))
+		}
+		if c.GetTimeout() != nil {
+			opts = append(opts, otlptracehttp.WithTimeout(c.Timeout.AsDuration()))
+		}
+		return otlptrace.New(context.Background(), otlptracehttp.NewClient(opts...))
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	default:
+		return nil, fmt.Errorf("tracing: unknown exporter %q", c.GetExporter())
+	}
+}
@@ -0,0 +1,102 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.opentelemetry.io/otel/propagation"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestNewTracerProvider(t *testing.T) {
+	tp, cleanup, err := NewTracerProvider(&Config{Exporter: ExporterNone}, "casso.user.service", "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+	_, span := tp.Tracer("test").Start(context.Background(), "op")
+	if span.SpanContext().IsSampled() {
+		t.Error("exporter none should not sample")
+	}
+	span.End()
+
+	if _, _, err := NewTracerProvider(&Config{Exporter: "jaeger"}, "casso.user.service", "v1"); err == nil {
+		t.Error("want error for unknown exporter")
+	}
+
+	tp, cleanup, err = NewTracerProvider(&Config{Exporter: ExporterStdout, SampleRatio: wrapperspb.Double(0)}, "casso.user.service", "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+	_, span = tp.Tracer("test").Start(context.Background(), "op")
+	if span.SpanContext().IsSampled() {
+		t.Error("ratio 0 should not sample")
+	}
+	span.End()
+}
+
+func TestKafka(t *testing.T) {
+	// 设置全局 propagator
+	_, cleanup, err := NewTracerProvider(&Config{Exporter: ExporterNone}, "test", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	sr := tracetest.NewSpanRecorder()
+	tp := tracesdk.NewTracerProvider(tracesdk.WithSpanProcessor(sr))
+
+	headers := propagation.MapCarrier{}
+	_, produce := Producer(context.Background(), tp, "box-opened", headers)
+	produce.End()
+	if headers["traceparent"] == "" {
+		t.Fatal("traceparent not injected")
+	}
+
+	_, consume := Consumer(context.Background(), tp, "box-opened", "shop", headers)
+	consume.End()
+
+	spans := sr.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("got %d spans", len(spans))
+	}
+	if spans[1].Parent().SpanID() != spans[0].SpanContext().SpanID() {
+		t.Error("consumer span should be child of producer span")
+	}
+	if spans[1].SpanKind() != trace.SpanKindConsumer {
+		t.Errorf("kind = %v", spans[1].SpanKind())
+	}
+}
+
+func TestRedis(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	tp := tracesdk.NewTracerProvider(tracesdk.WithSpanProcessor(sr))
+	rd := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", DialTimeout: 100 * time.Millisecond, MaxRetries: -1})
+	defer rd.Close()
+	Redis(rd, tp)
+
+	// 没有上游 span 不记录
+	_ = rd.Get(context.Background(), "key").Err()
+	ctx, root := tp.Tracer("test").Start(context.Background(), "root")
+	_ = rd.Set(ctx, "key", "secret", 0).Err()
+	root.End()
+
+	spans := sr.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("got %d spans", len(spans))
+	}
+	if spans[0].Name() != "redis.set" {
+		t.Errorf("name = %s", spans[0].Name())
+	}
+	for _, kv := range spans[0].Attributes() {
+		if kv.Key == "db.statement" && kv.Value.AsString() != "set key" {
+			t.Errorf("statement = %s", kv.Value.AsString())
+		}
+	}
+}
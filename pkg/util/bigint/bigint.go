@@ -1,3 +1,6 @@
+// Package bigint 大数金额辅助方法
+//
+// Deprecated: 金额统一使用 casso/pkg/money，以最小货币单位的整数加货币代码表示
 package bigint
 
 import (
@@ -8,35 +11,50 @@ import (
 )
 
 // PrecisionPrice 转大数专用 传入 价格，精度
+//
+// Deprecated: 使用 money.New / money.Parse
 func ParserPrice(price uint64, precision int64) (res string, err error) {
-	if precision == 0 {
-		res = fmt.Sprintf("%v", price)
-		return
+	if precision < 0 {
+		return "", fmt.Errorf("bigint: negative precision %d", precision)
 	}
-	i, err := strconv.ParseFloat(fmt.Sprintf("1e+%v", precision), 64)
-	if err != nil {
-		return
-	}
-
-	res = new(big.Int).Mul(big.NewInt(int64(i)), new(big.Int).SetUint64(price)).String()
+	// 直接计算 10 的幂，避免经 float64 转换在精度较大时溢出或失真
+	exp := new(big.Int).Exp(big.NewInt(10), big.NewInt(precision), nil)
+	res = new(big.Int).Mul(exp, new(big.Int).SetUint64(price)).String()
 	return
 }
 
 // Commisssion 获取扣除手续费后的金额  传入 总价大数，手续费（例如0.2） 返回字符串
+// 结果按银行家舍入取整
+//
+// Deprecated: 使用 money.Money.MulRat 与 money.ParseRate
 func Commisssion(total string, commiss float64) (string, error) {
-	var err error
 	bbint, ok := new(big.Int).SetString(total, 10)
 	if !ok {
 		return "", errors.New("bigint SetString fail")
 	}
-	f1 := big.NewFloat(float64(bbint.Int64()))
-	f3 := big.NewFloat(float64(bbint.Int64()))
-	yk := f1.Mul(f1, big.NewFloat(commiss))
-	result := f1.Sub(f3, yk)
-	return result.String(), err
+	// 按十进制字面量解析手续费，0.2 即精确的 1/5
+	rate, ok := new(big.Rat).SetString(strconv.FormatFloat(commiss, 'f', -1, 64))
+	if !ok {
+		return "", errors.New("bigint invalid commission")
+	}
+	rest := new(big.Rat).Sub(big.NewRat(1, 1), rate)
+	result := new(big.Rat).Mul(new(big.Rat).SetInt(bbint), rest)
+
+	q, r := new(big.Int).QuoRem(result.Num(), result.Denom(), new(big.Int))
+	twice := new(big.Int).Lsh(r.Abs(r), 1)
+	if c := twice.Cmp(result.Denom()); c > 0 || (c == 0 && q.Bit(0) == 1) {
+		if result.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q.String(), nil
 }
 
-//StringToBigInt string to bigint
+// StringToBigInt string to bigint
+//
+// Deprecated: 使用 money.Parse
 func StringToBigInt(amount string) (*big.Int, bool) {
 	return new(big.Int).SetString(amount, 10)
 }
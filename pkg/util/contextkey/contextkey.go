@@ -1,14 +1,124 @@
+/*
+ * 类型化的请求上下文：
+ *
+ *	ctx = contextkey.UserID.With(ctx, 10086)
+ *	uid, ok := contextkey.UserID.From(ctx)
+ *
+ * 通过 NewPropagated 定义的 key 可由 Server / Client 中间件经 HTTP 请求头或 gRPC metadata
+ * 在服务间传递，请求头使用 kratos 全局元数据前缀 x-md-global-，与 metadata 中间件兼容
+ */
 package contextkey
 
-// 自定义类型，用户context赋值
-type Key string
-type Val string
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// Key 类型化的 context key，以指针区分，不同包定义的同名 key 不会冲突
+type Key[T any] struct {
+	name   string
+	header string // 服务间传递使用的请求头，为空时只在进程内使用
+	encode func(T) string
+	decode func(string) (T, error)
+}
+
+// New 只在进程内使用的 key
+func New[T any](name string) *Key[T] {
+	return &Key[T]{name: name}
+}
+
+// NewPropagated 可在服务间传递的 key，encode / decode 负责与请求头的字符串互转
+func NewPropagated[T any](name, header string, encode func(T) string, decode func(string) (T, error)) *Key[T] {
+	return &Key[T]{name: name, header: header, encode: encode, decode: decode}
+}
+
+func (k *Key[T]) Name() string {
+	return k.name
+}
+
+// Header 服务间传递使用的请求头
+func (k *Key[T]) Header() string {
+	return k.header
+}
+
+// With 将 v 保存到 ctx
+func (k *Key[T]) With(ctx context.Context, v T) context.Context {
+	return context.WithValue(ctx, k, v)
+}
+
+// From 获取 ctx 中的值
+func (k *Key[T]) From(ctx context.Context) (T, bool) {
+	v, ok := ctx.Value(k).(T)
+	return v, ok
+}
+
+// Value 获取 ctx 中的值，不存在时返回零值
+func (k *Key[T]) Value(ctx context.Context) T {
+	v, _ := k.From(ctx)
+	return v
+}
+
+func (k *Key[T]) String() string {
+	return "contextkey." + k.name
+}
+
+// ClientInfo 发起请求的客户端
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+	Platform  string // 客户端平台，如 ios、android、web
+	Version   string // 客户端版本
+}
 
 var (
-	UserID = "userid"
+	// UserID 登录用户ID，由鉴权中间件写入
+	UserID = NewPropagated("user_id", "x-md-global-uid", formatInt, parseInt)
+	// TenantID 租户
+	TenantID = NewPropagated("tenant_id", "x-md-global-tenant", formatString, parseString)
+	// Locale 请求语言，由 i18n 中间件写入
+	Locale = NewPropagated("locale", "x-md-global-locale", formatString, parseString)
+	// RequestID 请求ID，贯穿整个调用链
+	RequestID = NewPropagated("request_id", "x-md-global-request-id", formatString, parseString)
+	// Caller 发起请求的客户端
+	Caller = NewPropagated("caller", "x-md-global-caller", formatClient, parseClient)
+	// Canary 灰度版本，只路由到该版本的实例，见 pkg/balancer
+	Canary = NewPropagated("canary", "x-md-global-canary", formatString, parseString)
 )
 
-// NewKey return Key with key name
-func NewKey() Key {
-	return Key(UserID)
+// Propagated 默认在服务间传递的 key
+var Propagated = []Propagator{UserID, TenantID, Locale, RequestID, Caller, Canary}
+
+func formatInt(v int64) string {
+	return strconv.FormatInt(v, 10)
+}
+
+func parseInt(s string) (int64, error) {
+	return strconv.ParseInt(s, 10, 64)
+}
+
+func formatString(v string) string {
+	return v
+}
+
+func parseString(s string) (string, error) {
+	return s, nil
+}
+
+func formatClient(c ClientInfo) string {
+	v := url.Values{}
+	for k, s := range map[string]string{"ip": c.IP, "ua": c.UserAgent, "platform": c.Platform, "version": c.Version} {
+		if s != "" {
+			v.Set(k, s)
+		}
+	}
+	return v.Encode()
+}
+
+func parseClient(s string) (ClientInfo, error) {
+	v, err := url.ParseQuery(s)
+	if err != nil {
+		return ClientInfo{}, err
+	}
+	return ClientInfo{IP: v.Get("ip"), UserAgent: v.Get("ua"), Platform: v.Get("platform"), Version: v.Get("version")}, nil
 }
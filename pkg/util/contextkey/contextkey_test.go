@@ -0,0 +1,111 @@
+package contextkey
+
+import (
+	"context"
+	nethttp "net/http"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+type headerCarrier map[string]string
+
+func (h headerCarrier) Get(key string) string      { return h[key] }
+func (h headerCarrier) Set(key string, val string) { h[key] = val }
+func (h headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+type testTransport struct {
+	header headerCarrier
+	req    *nethttp.Request
+}
+
+func (t *testTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (t *testTransport) Endpoint() string                { return "" }
+func (t *testTransport) Operation() string               { return "/test" }
+func (t *testTransport) RequestHeader() transport.Header { return t.header }
+func (t *testTransport) ReplyHeader() transport.Header   { return headerCarrier{} }
+func (t *testTransport) Request() *nethttp.Request       { return t.req }
+
+func TestKey(t *testing.T) {
+	ctx := context.Background()
+	if _, ok := UserID.From(ctx); ok {
+		t.Fatal("empty context has user id")
+	}
+	ctx = UserID.With(ctx, 10086)
+	if uid := UserID.Value(ctx); uid != 10086 {
+		t.Fatalf("UserID = %d", uid)
+	}
+	// 同名同类型的 key 互不影响
+	other := New[int64]("user_id")
+	if _, ok := other.From(ctx); ok {
+		t.Fatal("keys with the same name collide")
+	}
+}
+
+func TestPropagation(t *testing.T) {
+	client := ClientInfo{IP: "10.0.0.1", UserAgent: "Mozilla/5.0 (iPhone)", Platform: "ios", Version: "1.2.0"}
+	ctx := UserID.With(context.Background(), 10086)
+	ctx = Locale.With(ctx, "en-US")
+	ctx = RequestID.With(ctx, "req-1")
+	ctx = Caller.With(ctx, client)
+
+	header := headerCarrier{}
+	_, _ = Client()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})(transport.NewClientContext(ctx, &testTransport{header: header}), nil)
+	if header[UserID.Header()] != "10086" {
+		t.Fatalf("header = %v", header)
+	}
+	if _, ok := header[TenantID.Header()]; ok {
+		t.Fatal("unset key propagated")
+	}
+
+	// 不合法的值被忽略
+	header[TenantID.Header()] = "t1"
+	var got context.Context
+	_, _ = Server()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		got = ctx
+		return nil, nil
+	})(transport.NewServerContext(context.Background(), &testTransport{header: header}), nil)
+	if UserID.Value(got) != 10086 || Locale.Value(got) != "en-US" || RequestID.Value(got) != "req-1" || TenantID.Value(got) != "t1" {
+		t.Fatal("values lost in propagation")
+	}
+	if Caller.Value(got) != client {
+		t.Fatalf("Caller = %+v", Caller.Value(got))
+	}
+
+	header[UserID.Header()] = "abc"
+	_, _ = Server(UserID)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		got = ctx
+		return nil, nil
+	})(transport.NewServerContext(context.Background(), &testTransport{header: header}), nil)
+	if _, ok := UserID.From(got); ok {
+		t.Fatal("invalid user id accepted")
+	}
+}
+
+func TestEdge(t *testing.T) {
+	r, _ := nethttp.NewRequest("GET", "/v1/boxes", nil)
+	r.RemoteAddr = "192.168.1.2:52100"
+	r.Header.Set("X-Forwarded-For", "1.2.3.4, 10.0.0.1")
+	r.Header.Set("X-Request-Id", "req-2")
+	r.Header.Set("User-Agent", "curl/7.79.1")
+
+	var got context.Context
+	_, _ = Edge()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		got = ctx
+		return nil, nil
+	})(transport.NewServerContext(context.Background(), &testTransport{header: headerCarrier{}, req: r}), nil)
+	if c := Caller.Value(got); c.IP != "1.2.3.4" || c.UserAgent != "curl/7.79.1" {
+		t.Fatalf("Caller = %+v", c)
+	}
+	if RequestID.Value(got) != "req-2" {
+		t.Fatal("request id not set")
+	}
+}
@@ -0,0 +1,126 @@
+package contextkey
+
+import (
+	"context"
+	"net"
+	nethttp "net/http"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// Propagator 可在服务间传递的 key，由 NewPropagated 创建
+type Propagator interface {
+	Header() string
+	inject(ctx context.Context, h transport.Header)
+	extract(ctx context.Context, h transport.Header) context.Context
+}
+
+func (k *Key[T]) inject(ctx context.Context, h transport.Header) {
+	if k.header == "" {
+		return
+	}
+	if v, ok := k.From(ctx); ok {
+		if s := k.encode(v); s != "" {
+			h.Set(k.header, s)
+		}
+	}
+}
+
+// extract 请求头不存在或解析失败时不写入
+func (k *Key[T]) extract(ctx context.Context, h transport.Header) context.Context {
+	if k.header == "" {
+		return ctx
+	}
+	s := h.Get(k.header)
+	if s == "" {
+		return ctx
+	}
+	v, err := k.decode(s)
+	if err != nil {
+		return ctx
+	}
+	return k.With(ctx, v)
+}
+
+// Server 从请求头（HTTP header 或 gRPC metadata）读取 keys 保存到 context，未指定时读取 Propagated；
+// 面向外部客户端的入口不能信任客户端传入的用户ID，应只传入需要的 key
+func Server(keys ...Propagator) middleware.Middleware {
+	if len(keys) == 0 {
+		keys = Propagated
+	}
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				for _, k := range keys {
+					ctx = k.extract(ctx, tr.RequestHeader())
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// Client 将 context 中的 keys 写入请求头传递给下游，未指定时传递 Propagated
+func Client(keys ...Propagator) middleware.Middleware {
+	if len(keys) == 0 {
+		keys = Propagated
+	}
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromClientContext(ctx); ok {
+				for _, k := range keys {
+					k.inject(ctx, tr.RequestHeader())
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// Edge 对外 HTTP 入口：从常用请求头解析请求ID（X-Request-Id）、租户（X-Tenant-Id）、灰度版本（X-Canary）与客户端信息；
+// 客户端 IP 优先取网关设置的 X-Real-IP / X-Forwarded-For
+func Edge() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				if ht, ok := tr.(interface{ Request() *nethttp.Request }); ok {
+					ctx = fromRequest(ctx, ht.Request())
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+func fromRequest(ctx context.Context, r *nethttp.Request) context.Context {
+	if id := r.Header.Get("X-Request-Id"); id != "" {
+		ctx = RequestID.With(ctx, id)
+	}
+	if tenant := r.Header.Get("X-Tenant-Id"); tenant != "" {
+		ctx = TenantID.With(ctx, tenant)
+	}
+	if canary := r.Header.Get("X-Canary"); canary != "" {
+		ctx = Canary.With(ctx, canary)
+	}
+	return Caller.With(ctx, ClientInfo{
+		IP:        clientIP(r),
+		UserAgent: r.UserAgent(),
+		Platform:  r.Header.Get("Platform"),
+		Version:   r.Header.Get("Version"),
+	})
+}
+
+func clientIP(r *nethttp.Request) string {
+	if ip := strings.TrimSpace(r.Header.Get("X-Real-IP")); ip != "" {
+		return ip
+	}
+	if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
+		return strings.TrimSpace(strings.Split(xff, ",")[0])
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
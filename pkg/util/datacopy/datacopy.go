@@ -15,6 +15,8 @@ import (
 )
 
 // DataCopy 本来想使用reflect包来完成这个功能，json.Marshal()其实也是借助reflect包来实现的.
+//
+// Deprecated: 经 JSON 往返复制，int64 精度、时间类型与 proto 消息都无法正确处理，使用 mapper.Map
 func DataCopy(data, res interface{}) error {
 	b, err := json.Marshal(data)
 	if err != nil {
@@ -24,12 +26,18 @@ func DataCopy(data, res interface{}) error {
 }
 
 // DataReflact 传入*reflect.Value 且返回 *reflect.Value ，将类型交给调用者来决定。
+//
+// Deprecated: 使用 mapper.Map
 func DataReflact(src, dst *reflect.Value) *reflect.Value {
 	for i := 0; i < src.NumField(); i++ {
-		filed := src.Type().Field(i).Name
-		cfiled := dst.FieldByName(filed)
-		if cfiled.IsValid() && cfiled.CanSet() { // 可用的属性且可 赋值
-			dst.Field(i).Set(src.Field(i))
+		f := src.Type().Field(i)
+		if f.PkgPath != "" { // 非导出字段不可读取赋值
+			continue
+		}
+		cfiled := dst.FieldByName(f.Name)
+		// 按名称找到的目标字段赋值，字段顺序不同时不能按下标赋值
+		if cfiled.IsValid() && cfiled.CanSet() && f.Type.AssignableTo(cfiled.Type()) {
+			cfiled.Set(src.Field(i))
 		}
 	}
 	return dst
@@ -0,0 +1,303 @@
+package mapper
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// convFunc 将 src 转换后写入 dst，dst 可寻址
+type convFunc func(dst, src reflect.Value) error
+
+var (
+	errorType     = reflect.TypeOf((*error)(nil)).Elem()
+	timeType      = reflect.TypeOf(time.Time{})
+	durationType  = reflect.TypeOf(time.Duration(0))
+	timestampType = reflect.TypeOf((*timestamppb.Timestamp)(nil))
+	durationPType = reflect.TypeOf((*durationpb.Duration)(nil))
+)
+
+// converter 返回 src 类型到 dst 类型的转换，无法转换时返回 nil
+func (m *Mapper) converter(src, dst reflect.Type) convFunc {
+	if fn, ok := m.converters[typePair{src, dst}]; ok {
+		return custom(fn)
+	}
+	if conv := m.wellKnown(src, dst); conv != nil {
+		return conv
+	}
+	if src.AssignableTo(dst) {
+		return func(dst, src reflect.Value) error {
+			dst.Set(src)
+			return nil
+		}
+	}
+
+	switch {
+	case isNumber(src.Kind()) && isNumber(dst.Kind()):
+		return numberConverter(src, dst)
+	case src.Kind() == reflect.String && dst.Kind() == reflect.String,
+		src.Kind() == reflect.Bool && dst.Kind() == reflect.Bool:
+		return func(dst, src reflect.Value) error {
+			dst.Set(src.Convert(dst.Type()))
+			return nil
+		}
+	}
+
+	switch {
+	case src.Kind() == reflect.Ptr && dst.Kind() == reflect.Ptr:
+		elem := m.converter(src.Elem(), dst.Elem())
+		if elem == nil {
+			return nil
+		}
+		return func(dst, src reflect.Value) error {
+			if src.IsNil() {
+				dst.Set(reflect.Zero(dst.Type()))
+				return nil
+			}
+			v := reflect.New(dst.Type().Elem())
+			if err := elem(v.Elem(), src.Elem()); err != nil {
+				return err
+			}
+			dst.Set(v)
+			return nil
+		}
+	case src.Kind() == reflect.Ptr:
+		elem := m.converter(src.Elem(), dst)
+		if elem == nil {
+			return nil
+		}
+		return func(dst, src reflect.Value) error {
+			if src.IsNil() {
+				dst.Set(reflect.Zero(dst.Type()))
+				return nil
+			}
+			return elem(dst, src.Elem())
+		}
+	case dst.Kind() == reflect.Ptr:
+		elem := m.converter(src, dst.Elem())
+		if elem == nil {
+			return nil
+		}
+		return func(dst, src reflect.Value) error {
+			v := reflect.New(dst.Type().Elem())
+			if err := elem(v.Elem(), src); err != nil {
+				return err
+			}
+			dst.Set(v)
+			return nil
+		}
+	case src.Kind() == reflect.Struct && dst.Kind() == reflect.Struct:
+		// 嵌套结构体在执行时再取映射计划，支持递归类型
+		return m.mapStruct
+	case src.Kind() == reflect.Slice && dst.Kind() == reflect.Slice:
+		elem := m.converter(src.Elem(), dst.Elem())
+		if elem == nil {
+			return nil
+		}
+		return func(dst, src reflect.Value) error {
+			if src.IsNil() {
+				dst.Set(reflect.Zero(dst.Type()))
+				return nil
+			}
+			out := reflect.MakeSlice(dst.Type(), src.Len(), src.Len())
+			for i := 0; i < src.Len(); i++ {
+				if err := elem(out.Index(i), src.Index(i)); err != nil {
+					return fmt.Errorf("[%d]: %w", i, err)
+				}
+			}
+			dst.Set(out)
+			return nil
+		}
+	case src.Kind() == reflect.Map && dst.Kind() == reflect.Map:
+		key := m.converter(src.Key(), dst.Key())
+		elem := m.converter(src.Elem(), dst.Elem())
+		if key == nil || elem == nil {
+			return nil
+		}
+		return func(dst, src reflect.Value) error {
+			if src.IsNil() {
+				dst.Set(reflect.Zero(dst.Type()))
+				return nil
+			}
+			out := reflect.MakeMapWithSize(dst.Type(), src.Len())
+			iter := src.MapRange()
+			for iter.Next() {
+				k := reflect.New(dst.Type().Key()).Elem()
+				if err := key(k, iter.Key()); err != nil {
+					return err
+				}
+				v := reflect.New(dst.Type().Elem()).Elem()
+				if err := elem(v, iter.Value()); err != nil {
+					return fmt.Errorf("[%v]: %w", iter.Key(), err)
+				}
+				out.SetMapIndex(k, v)
+			}
+			dst.Set(out)
+			return nil
+		}
+	}
+	return nil
+}
+
+func custom(fn reflect.Value) convFunc {
+	return func(dst, src reflect.Value) error {
+		out := fn.Call([]reflect.Value{src})
+		if len(out) == 2 && !out[1].IsNil() {
+			return out[1].Interface().(error)
+		}
+		dst.Set(out[0])
+		return nil
+	}
+}
+
+// wellKnown 时间相关的内置转换：time.Time、整数时间戳与 Timestamp，time.Duration 与 Duration
+func (m *Mapper) wellKnown(src, dst reflect.Type) convFunc {
+	switch {
+	case src == timeType && dst == timestampType:
+		return func(dst, src reflect.Value) error {
+			t := src.Interface().(time.Time)
+			if t.IsZero() {
+				dst.Set(reflect.Zero(dst.Type()))
+				return nil
+			}
+			dst.Set(reflect.ValueOf(timestamppb.New(t)))
+			return nil
+		}
+	case src == timestampType && dst == timeType:
+		return func(dst, src reflect.Value) error {
+			ts := src.Interface().(*timestamppb.Timestamp)
+			if ts == nil {
+				dst.Set(reflect.Zero(dst.Type()))
+				return nil
+			}
+			dst.Set(reflect.ValueOf(ts.AsTime()))
+			return nil
+		}
+	case src == durationType && dst == durationPType:
+		return func(dst, src reflect.Value) error {
+			dst.Set(reflect.ValueOf(durationpb.New(time.Duration(src.Int()))))
+			return nil
+		}
+	case src == durationPType && dst == durationType:
+		return func(dst, src reflect.Value) error {
+			d := src.Interface().(*durationpb.Duration)
+			dst.SetInt(int64(d.AsDuration()))
+			return nil
+		}
+	case isInteger(src.Kind()) && dst == timestampType:
+		unit := int64(m.epoch)
+		return func(dst, src reflect.Value) error {
+			v := toInt64(src)
+			// 0 表示未设置，如 DeleteTime
+			if v == 0 {
+				dst.Set(reflect.Zero(dst.Type()))
+				return nil
+			}
+			dst.Set(reflect.ValueOf(timestamppb.New(time.Unix(0, v*unit))))
+			return nil
+		}
+	case src == timestampType && isInteger(dst.Kind()):
+		unit := int64(m.epoch)
+		return func(dst, src reflect.Value) error {
+			ts := src.Interface().(*timestamppb.Timestamp)
+			if ts == nil {
+				dst.Set(reflect.Zero(dst.Type()))
+				return nil
+			}
+			return setInt(dst, ts.AsTime().UnixNano()/unit)
+		}
+	}
+	return nil
+}
+
+// numberConverter 数值类型互转，溢出或负数转无符号时报错；浮点数不转换为整数，避免静默丢失精度
+func numberConverter(src, dst reflect.Type) convFunc {
+	switch {
+	case isFloat(src.Kind()) && !isFloat(dst.Kind()):
+		return nil
+	case isFloat(src.Kind()):
+		return func(dst, src reflect.Value) error {
+			f := src.Float()
+			if dst.OverflowFloat(f) {
+				return fmt.Errorf("mapper: %v overflows %s", f, dst.Type())
+			}
+			dst.SetFloat(f)
+			return nil
+		}
+	case isFloat(dst.Kind()):
+		return func(dst, src reflect.Value) error {
+			if isUnsigned(src.Kind()) {
+				dst.SetFloat(float64(src.Uint()))
+			} else {
+				dst.SetFloat(float64(src.Int()))
+			}
+			return nil
+		}
+	case isUnsigned(src.Kind()):
+		return func(dst, src reflect.Value) error {
+			u := src.Uint()
+			if isUnsigned(dst.Kind()) {
+				if dst.OverflowUint(u) {
+					return fmt.Errorf("mapper: %d overflows %s", u, dst.Type())
+				}
+				dst.SetUint(u)
+				return nil
+			}
+			if u > math.MaxInt64 {
+				return fmt.Errorf("mapper: %d overflows %s", u, dst.Type())
+			}
+			return setInt(dst, int64(u))
+		}
+	default:
+		return func(dst, src reflect.Value) error {
+			return setInt(dst, src.Int())
+		}
+	}
+}
+
+// setInt 写入有符号或无符号整数
+func setInt(dst reflect.Value, v int64) error {
+	if isUnsigned(dst.Kind()) {
+		if v < 0 || dst.OverflowUint(uint64(v)) {
+			return fmt.Errorf("mapper: %d overflows %s", v, dst.Type())
+		}
+		dst.SetUint(uint64(v))
+		return nil
+	}
+	if dst.OverflowInt(v) {
+		return fmt.Errorf("mapper: %d overflows %s", v, dst.Type())
+	}
+	dst.SetInt(v)
+	return nil
+}
+
+func toInt64(v reflect.Value) int64 {
+	if isUnsigned(v.Kind()) {
+		return int64(v.Uint())
+	}
+	return v.Int()
+}
+
+func isNumber(k reflect.Kind) bool {
+	return isInteger(k) || isFloat(k)
+}
+
+func isInteger(k reflect.Kind) bool {
+	return isSigned(k) || isUnsigned(k)
+}
+
+func isSigned(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Int64
+}
+
+func isUnsigned(k reflect.Kind) bool {
+	return k >= reflect.Uint && k <= reflect.Uintptr
+}
+
+func isFloat(k reflect.Kind) bool {
+	return k == reflect.Float32 || k == reflect.Float64
+}
@@ -0,0 +1,229 @@
+// Package mapper 结构体映射，用于 model 与 api proto 消息之间的转换，替代 datacopy
+//
+// 字段按名称匹配（忽略大小写与下划线，ID 与 Id、nick_name 与 NickName 视为同名），
+// 可通过 `mapper:"NickName"` 标签重命名、`mapper:"-"` 忽略；映射计划按类型对缓存，只在首次使用时反射
+package mapper
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const tagName = "mapper"
+
+// Mapper 映射器，创建后并发安全
+type Mapper struct {
+	strict     bool
+	epoch      time.Duration
+	converters map[typePair]reflect.Value
+	renames    map[typePair]map[string]string
+	plans      sync.Map // typePair -> *plan
+}
+
+type typePair struct {
+	src, dst reflect.Type
+}
+
+// Option 映射器配置
+type Option func(*Mapper)
+
+// Strict 严格模式：目标结构体中存在未被映射的导出字段时返回 *UnmappedError
+func Strict() Option {
+	return func(m *Mapper) {
+		m.strict = true
+	}
+}
+
+// WithEpochUnit 整数时间戳与 Timestamp 互转时的单位，默认秒
+func WithEpochUnit(unit time.Duration) Option {
+	return func(m *Mapper) {
+		m.epoch = unit
+	}
+}
+
+// WithConverter 自定义类型转换，fn 形如 func(S) D 或 func(S) (D, error)，优先于内置转换
+func WithConverter(fn interface{}) Option {
+	v := reflect.ValueOf(fn)
+	t := v.Type()
+	if t.Kind() != reflect.Func || t.NumIn() != 1 || t.NumOut() < 1 || t.NumOut() > 2 ||
+		(t.NumOut() == 2 && t.Out(1) != errorType) {
+		panic(fmt.Sprintf("mapper: converter must be func(S) D or func(S) (D, error), got %s", t))
+	}
+	return func(m *Mapper) {
+		m.converters[typePair{t.In(0), t.Out(0)}] = v
+	}
+}
+
+// WithRename 为无法加标签的类型（如 proto 消息）指定字段对应关系，fields 为 源字段名 -> 目标字段名
+func WithRename(src, dst interface{}, fields map[string]string) Option {
+	pair := typePair{indirectType(reflect.TypeOf(src)), indirectType(reflect.TypeOf(dst))}
+	return func(m *Mapper) {
+		if m.renames[pair] == nil {
+			m.renames[pair] = map[string]string{}
+		}
+		for k, v := range fields {
+			m.renames[pair][k] = v
+		}
+	}
+}
+
+// New 创建映射器
+func New(opts ...Option) *Mapper {
+	m := &Mapper{
+		epoch:      time.Second,
+		converters: map[typePair]reflect.Value{},
+		renames:    map[typePair]map[string]string{},
+	}
+	for _, o := range opts {
+		o(m)
+	}
+	return m
+}
+
+var std = New()
+
+// Map 使用默认映射器将 src 映射到 dst
+func Map(dst, src interface{}) error {
+	return std.Map(dst, src)
+}
+
+// Map 将 src 映射到 dst，dst 必须为非 nil 指针；src 可以是结构体、切片或其指针
+func (m *Mapper) Map(dst, src interface{}) error {
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Ptr || dv.IsNil() {
+		return fmt.Errorf("mapper: dst must be a non-nil pointer, got %T", dst)
+	}
+	sv := reflect.ValueOf(src)
+	if !sv.IsValid() {
+		return nil
+	}
+	// 指针到指针时映射其指向的值，避免目标指针被直接替换
+	if sv.Kind() == reflect.Ptr && sv.Type() == dv.Type() {
+		if sv.IsNil() {
+			return nil
+		}
+		sv = sv.Elem()
+	}
+	conv := m.converter(sv.Type(), dv.Elem().Type())
+	if conv == nil {
+		return fmt.Errorf("mapper: cannot map %s to %s", sv.Type(), dv.Elem().Type())
+	}
+	return conv(dv.Elem(), sv)
+}
+
+// UnmappedError 严格模式下目标中没有来源或类型无法转换的字段
+type UnmappedError struct {
+	Src, Dst reflect.Type
+	Fields   []string
+}
+
+func (e *UnmappedError) Error() string {
+	return fmt.Sprintf("mapper: %s -> %s unmapped fields: %s", e.Src, e.Dst, strings.Join(e.Fields, ", "))
+}
+
+type fieldOp struct {
+	name     string
+	src, dst []int
+	conv     convFunc
+}
+
+type plan struct {
+	ops      []fieldOp
+	unmapped []string
+}
+
+type field struct {
+	name  string // Go 字段名
+	key   string // 匹配用名称
+	index []int
+	typ   reflect.Type
+}
+
+func (m *Mapper) plan(src, dst reflect.Type) *plan {
+	pair := typePair{src, dst}
+	if p, ok := m.plans.Load(pair); ok {
+		return p.(*plan)
+	}
+
+	renames := m.renames[pair]
+	srcFields := map[string]field{}
+	for _, f := range fields(src, nil) {
+		if to, ok := renames[f.name]; ok {
+			f.key = normalize(to)
+		}
+		srcFields[f.key] = f
+	}
+
+	p := &plan{}
+	for _, df := range fields(dst, nil) {
+		sf, ok := srcFields[df.key]
+		if !ok {
+			p.unmapped = append(p.unmapped, df.name)
+			continue
+		}
+		conv := m.converter(sf.typ, df.typ)
+		if conv == nil {
+			p.unmapped = append(p.unmapped, fmt.Sprintf("%s(%s -> %s)", df.name, sf.typ, df.typ))
+			continue
+		}
+		p.ops = append(p.ops, fieldOp{name: df.name, src: sf.index, dst: df.index, conv: conv})
+	}
+	sort.Strings(p.unmapped)
+
+	actual, _ := m.plans.LoadOrStore(pair, p)
+	return actual.(*plan)
+}
+
+func (m *Mapper) mapStruct(dst, src reflect.Value) error {
+	p := m.plan(src.Type(), dst.Type())
+	if m.strict && len(p.unmapped) > 0 {
+		return &UnmappedError{Src: src.Type(), Dst: dst.Type(), Fields: p.unmapped}
+	}
+	for _, op := range p.ops {
+		if err := op.conv(dst.FieldByIndex(op.dst), src.FieldByIndex(op.src)); err != nil {
+			return fmt.Errorf("%s.%s: %w", dst.Type().Name(), op.name, err)
+		}
+	}
+	return nil
+}
+
+// fields 导出字段，展开匿名的结构体字段（不展开指针，避免访问 nil）
+func fields(t reflect.Type, prefix []int) []field {
+	var out []field
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get(tagName)
+		if tag == "-" {
+			continue
+		}
+		index := append(append([]int(nil), prefix...), i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct && tag == "" {
+			out = append(out, fields(f.Type, index)...)
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		name := f.Name
+		if tag != "" {
+			name = tag
+		}
+		out = append(out, field{name: f.Name, key: normalize(name), index: index, typ: f.Type})
+	}
+	return out
+}
+
+func normalize(name string) string {
+	return strings.ToLower(strings.Replace(name, "_", "", -1))
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
@@ -0,0 +1,133 @@
+package mapper
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type base struct {
+	CreatedTime int64
+}
+
+type user struct {
+	base
+	ID     uint32
+	Name   string `mapper:"NickName"`
+	Mobile string
+	Pass   string
+	Tags   []tag
+}
+
+type tag struct {
+	Name string
+}
+
+type userReply struct {
+	Id          int64
+	NickName    string
+	Mobile      string
+	CreatedTime *timestamppb.Timestamp
+	Tags        []*tagReply
+
+	state int // 模拟 proto 的非导出字段
+}
+
+type tagReply struct {
+	Name string
+}
+
+func TestMap(t *testing.T) {
+	src := &user{
+		base:   base{CreatedTime: 1650000000},
+		ID:     7,
+		Name:   "casso",
+		Mobile: "13800000000",
+		Pass:   "secret",
+		Tags:   []tag{{Name: "a"}, {Name: "b"}},
+	}
+	var dst userReply
+	if err := Map(&dst, src); err != nil {
+		t.Fatal(err)
+	}
+	if dst.Id != 7 || dst.NickName != "casso" || dst.Mobile != "13800000000" {
+		t.Fatalf("unexpected %+v", dst)
+	}
+	if !dst.CreatedTime.AsTime().Equal(time.Unix(1650000000, 0)) {
+		t.Fatalf("created time = %v", dst.CreatedTime.AsTime())
+	}
+	if len(dst.Tags) != 2 || dst.Tags[1].Name != "b" {
+		t.Fatalf("tags = %+v", dst.Tags)
+	}
+
+	// 反向映射
+	var back user
+	if err := Map(&back, &dst); err != nil {
+		t.Fatal(err)
+	}
+	if back.ID != 7 || back.Name != "casso" || back.CreatedTime != 1650000000 || back.Pass != "" {
+		t.Fatalf("unexpected %+v", back)
+	}
+}
+
+func TestMapSlice(t *testing.T) {
+	var dst []*userReply
+	if err := Map(&dst, []*user{{ID: 1}, {ID: 2}}); err != nil {
+		t.Fatal(err)
+	}
+	if len(dst) != 2 || dst[0].Id != 1 || dst[1].Id != 2 {
+		t.Fatalf("unexpected %+v", dst)
+	}
+}
+
+func TestOverflow(t *testing.T) {
+	type big struct{ ID int64 }
+	type small struct{ ID uint32 }
+	var dst small
+	err := Map(&dst, big{ID: -1})
+	if err == nil || !strings.Contains(err.Error(), "overflows") {
+		t.Fatalf("expected overflow error, got %v", err)
+	}
+}
+
+func TestStrict(t *testing.T) {
+	type src struct{ Mobile string }
+	type dst struct {
+		Mobile string
+		Age    int64
+	}
+	if err := Map(&dst{}, src{}); err != nil {
+		t.Fatalf("non-strict: %v", err)
+	}
+
+	var ue *UnmappedError
+	err := New(Strict()).Map(&dst{}, src{})
+	if !errors.As(err, &ue) || len(ue.Fields) != 1 || ue.Fields[0] != "Age" {
+		t.Fatalf("expected unmapped Age, got %v", err)
+	}
+}
+
+func TestRenameAndConverter(t *testing.T) {
+	type src struct {
+		Nick string
+		At   time.Time
+	}
+	type dst struct {
+		Name string
+		At   string
+	}
+	m := New(
+		WithRename(src{}, dst{}, map[string]string{"Nick": "Name"}),
+		WithConverter(func(t time.Time) string { return t.Format("2006-01-02") }),
+	)
+	var out dst
+	if err := m.Map(&out, src{Nick: "casso", At: time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)}); err != nil {
+		t.Fatal(err)
+	}
+	if out.Name != "casso" || out.At != "2022-05-01" {
+		t.Fatalf("unexpected %+v", out)
+	}
+}
@@ -0,0 +1,60 @@
+package mystery
+
+import "context"
+
+// RandomDraw 权重随机抽奖，返回奖品ID；奖池为空或权重均不为正时返回 -1
+//
+// Deprecated: 使用 Engine.Draw
+func RandomDraw(prizes []*Prize) int64 {
+	pool := Pool{}
+	for _, v := range prizes {
+		if v.Weight > 0 {
+			pool.Prizes = append(pool.Prizes, Prize{PlayerId: v.PlayerId, Weight: v.Weight, Stock: Unlimited})
+		}
+	}
+	e, err := NewEngine(pool, nil)
+	if err != nil {
+		return -1
+	}
+	res, err := e.Draw(context.Background(), State{}, CryptoSource)
+	if err != nil {
+		return -1
+	}
+	return res.PrizeID
+}
+
+// GetPrice 开启盲盒，返回中奖结果 奖品ID:数量，Weight 同时作为库存，开启后扣减
+//
+// Deprecated: 使用 Engine.DrawN
+func GetPrice(boxNum, avalable int64, prize []*Prize) map[int64]int64 {
+	pool := Pool{}
+	for _, v := range prize {
+		if v.Weight > 0 {
+			pool.Prizes = append(pool.Prizes, Prize{PlayerId: v.PlayerId, Weight: v.Weight, Stock: int64(v.Weight)})
+		}
+	}
+	res := make(map[int64]int64, avalable)
+	e, err := NewEngine(pool, nil)
+	if err != nil {
+		return res
+	}
+	results, _ := e.DrawN(context.Background(), State{}, CryptoSource, int(boxNum))
+	for _, r := range results {
+		res[r.PrizeID]++
+	}
+	for _, v := range prize {
+		v.Weight -= int(res[v.PlayerId])
+	}
+	return res
+}
+
+// GetRandPrize 随机获奖 输入盲盒数量，输出中奖ID:数量
+//
+// Deprecated: 使用 Engine.DrawN
+func GetRandPrize(boxNum int64, prize []Prize) map[int64]int64 {
+	ptrs := make([]*Prize, len(prize))
+	for i := range prize {
+		ptrs[i] = &prize[i]
+	}
+	return GetPrice(boxNum, boxNum, ptrs)
+}
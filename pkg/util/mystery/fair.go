@@ -0,0 +1,112 @@
+package mystery
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"sync"
+)
+
+// 可验证公平（commit-reveal）:
+//  1. 服务端生成 serverSeed，开奖前只公布承诺 Commit(serverSeed)
+//  2. 玩家提供 clientSeed，第 nonce 次抽奖的随机数为 HMAC-SHA256(serverSeed, clientSeed:nonce) 的前 8 字节
+//  3. 服务端轮换种子时公开旧 serverSeed，玩家校验承诺后用 Pool.Replay 重放每次抽奖
+//
+// 限制：售罄的奖品由服务端在 Result.SoldOut 中给出，玩家无法核实，校验只保证随机数与
+// 给定售罄列表下的抽取结果，不保证服务端没有谎报售罄
+
+// ErrCommitMismatch 公开的种子与承诺不一致
+var ErrCommitMismatch = errors.New("mystery: server seed does not match commitment")
+
+// ErrResultMismatch 重放结果与服务端给出的结果不一致
+var ErrResultMismatch = errors.New("mystery: replayed result mismatch")
+
+// NewServerSeed 生成 32 字节服务端种子
+func NewServerSeed() ([]byte, error) {
+	seed := make([]byte, 32)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, err
+	}
+	return seed, nil
+}
+
+// Commit 种子承诺，sha256 十六进制
+func Commit(serverSeed []byte) string {
+	sum := sha256.Sum256(serverSeed)
+	return hex.EncodeToString(sum[:])
+}
+
+// Roll 第 nonce 次抽奖的随机数
+func Roll(serverSeed []byte, clientSeed string, nonce uint64) uint64 {
+	mac := hmac.New(sha256.New, serverSeed)
+	mac.Write([]byte(clientSeed))
+	mac.Write([]byte{':'})
+	mac.Write([]byte(strconv.FormatUint(nonce, 10)))
+	return binary.BigEndian.Uint64(mac.Sum(nil))
+}
+
+// FairSource 可验证的随机源，每次取数 nonce 加一
+type FairSource struct {
+	mu     sync.Mutex
+	server []byte
+	client string
+	nonce  uint64
+}
+
+// NewFairSource nonce 为下一次抽奖使用的序号，由调用方与种子一起持久化
+func NewFairSource(serverSeed []byte, clientSeed string, nonce uint64) *FairSource {
+	return &FairSource{server: serverSeed, client: clientSeed, nonce: nonce}
+}
+
+func (s *FairSource) Uint64() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v := Roll(s.server, s.client, s.nonce)
+	s.nonce++
+	return v
+}
+
+// Nonce 下一次抽奖使用的序号
+func (s *FairSource) Nonce() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.nonce
+}
+
+// Verify 玩家侧校验：种子与承诺一致、随机数由种子生成、按抽奖前的 state 与 res.SoldOut 重放得到相同奖品。
+// res.SoldOut 按原样采信，见包说明中的限制
+// 校验通过后 state 推进到本次抽奖之后，可继续校验下一次
+func Verify(pool Pool, state State, commitment string, serverSeed []byte, clientSeed string, nonce uint64, res Result) error {
+	if subtle.ConstantTimeCompare([]byte(Commit(serverSeed)), []byte(commitment)) != 1 {
+		return ErrCommitMismatch
+	}
+	if Roll(serverSeed, clientSeed, nonce) != res.Roll {
+		return ErrResultMismatch
+	}
+	got, err := pool.Replay(state, res.Roll, res.SoldOut)
+	if err != nil {
+		return err
+	}
+	if got.PrizeID != res.PrizeID || got.Pity != res.Pity {
+		return ErrResultMismatch
+	}
+	return nil
+}
+
+type cryptoSource struct{}
+
+func (cryptoSource) Uint64() uint64 {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	return binary.BigEndian.Uint64(b[:])
+}
+
+// CryptoSource 基于 crypto/rand 的随机源，无需校验时使用
+var CryptoSource Source = cryptoSource{}
@@ -1,119 +1,270 @@
+// Package mystery 盲盒抽奖引擎
+//
+// 按权重抽取，奖品库存有限，支持保底规则；随机数由 Source 提供，
+// 使用 FairSource（commit-reveal）时玩家可以在服务端公开种子后用 Pool.Replay 校验每次结果。
+// 校验只能证明随机数未被操纵；Result.SoldOut 由服务端给出，玩家无法核实当时是否真的售罄，
+// 服务端谎报售罄可以把一次抽奖移到其他奖品上而仍然通过校验
 package mystery
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
-	"math"
-	"math/rand"
+	"math/bits"
 	"sort"
-	"time"
+)
+
+// Unlimited 不限库存
+const Unlimited int64 = -1
 
-	wr "github.com/mroth/weightedrand"
+var (
+	// ErrSoldOut 奖池中所有奖品都已售罄
+	ErrSoldOut = errors.New("mystery: all prizes sold out")
+	// ErrInvalidPool 奖池配置错误
+	ErrInvalidPool = errors.New("mystery: invalid pool")
 )
 
+// Prize 奖品
 type Prize struct {
-	PlayerId int64
-	Weight   int
-}
-
-// 权重随机抽奖
-func RandomDraw(prizes []*Prize) int64 {
-	//权重累加求和
-	var weightSum int
-	for _, v := range prizes {
-		weightSum += v.Weight
-	}
-
-	//生成一个权重随机数，介于0-weightSum之间
-	rand.Seed(time.Now().UnixNano())
-	randomNum := rand.Intn(weightSum)
-
-	//权重数组重组并排序
-	randomNumTmp := &Prize{PlayerId: -1, Weight: randomNum}
-	concatWeightArr := make([]*Prize, 0)
-	aa, _ := json.Marshal(prizes)
-	_ = json.Unmarshal(aa, &concatWeightArr)
-	concatWeightArr = append(concatWeightArr, randomNumTmp) //将随机数加入权重数组
-
-	//将包含随机数的新权重数组按从小到大（升序）排序
-	sort.Slice(concatWeightArr, func(i, j int) bool {
-		return concatWeightArr[i].Weight < concatWeightArr[j].Weight
-	})
-	sort.Slice(prizes, func(i, j int) bool {
-		return prizes[i].Weight < prizes[j].Weight
-	})
-
-	//索引权重随机数的数组下标
-	var randomNumIndex = -1 //索引随机数在新权重数组中的位置
-	for p, v := range concatWeightArr {
-		if v.Weight == randomNum {
-			randomNumIndex = p
-		}
-	}
-	randomNumIndexTmp := math.Min(float64(randomNumIndex), float64(len(prizes)-1)) //权重随机数的下标不得超过奖项数组的长度-1，重新计算随机数在奖项数组中的索引位置
-	randomNumIndex = int(randomNumIndexTmp)
-
-	//取出对应奖项
-	res := prizes[randomNumIndex] //从奖项数组中取出本次抽奖结果
-	fmt.Println("本次抽奖结果：", res.PlayerId)
-	return res.PlayerId
-}
-
-// GetPrice 开启盲盒，返回中奖结果map,可一次性多开
-func GetPrice(boxNum, avalable int64, prize []*Prize) map[int64]int64 {
-	var prizeRes = make(map[int64]int64, avalable) // 抽奖结果
-	for i := 0; i < int(boxNum); i++ {
-		pid := RandomDraw(prize)  // 传入奖池数据，返回抽中的PID,但是奖池数据不会变动
-		for i, v := range prize { // 每次减相应的数量，保证权重有变化
-			if v.PlayerId == pid {
-				if v.Weight < 1 {
-					prize = append(prize[:i], prize[i+1:]...)
-				}
-				v.Weight -= 1
+	PlayerId int64 // 奖品ID
+	Weight   int   // 抽取权重，需大于 0
+	Stock    int64 // 初始库存，Unlimited 表示不限量
+}
+
+// PityRule 保底规则
+// 连续 Ceiling-1 次未抽中 Prizes 中任一奖品时，下一次只在 Prizes 中抽取（硬保底）；
+// 连续未中次数达到 SoftStart 后，每多一次未中，Prizes 的权重增加 SoftStep 倍原始权重（软保底）
+type PityRule struct {
+	Name      string
+	Prizes    []int64
+	Ceiling   int // 为 0 时不启用硬保底
+	SoftStart int // 为 0 时不启用软保底
+	SoftStep  int
+}
+
+// Pool 奖池，Rules 按顺序优先，多个规则同时触发硬保底时取第一个
+type Pool struct {
+	Prizes []Prize
+	Rules  []PityRule
+}
+
+// Validate 校验奖池配置
+func (p *Pool) Validate() error {
+	if len(p.Prizes) == 0 {
+		return fmt.Errorf("%w: no prizes", ErrInvalidPool)
+	}
+	ids := make(map[int64]bool, len(p.Prizes))
+	for _, v := range p.Prizes {
+		if v.Weight <= 0 {
+			return fmt.Errorf("%w: prize %d weight must be positive", ErrInvalidPool, v.PlayerId)
+		}
+		if v.Stock < Unlimited {
+			return fmt.Errorf("%w: prize %d stock %d", ErrInvalidPool, v.PlayerId, v.Stock)
+		}
+		if ids[v.PlayerId] {
+			return fmt.Errorf("%w: duplicate prize %d", ErrInvalidPool, v.PlayerId)
+		}
+		ids[v.PlayerId] = true
+	}
+	names := make(map[string]bool, len(p.Rules))
+	for _, r := range p.Rules {
+		if r.Name == "" || names[r.Name] {
+			return fmt.Errorf("%w: rule name %q empty or duplicate", ErrInvalidPool, r.Name)
+		}
+		names[r.Name] = true
+		if len(r.Prizes) == 0 || r.Ceiling < 0 || r.SoftStart < 0 || r.SoftStep < 0 {
+			return fmt.Errorf("%w: rule %s", ErrInvalidPool, r.Name)
+		}
+		for _, id := range r.Prizes {
+			if !ids[id] {
+				return fmt.Errorf("%w: rule %s references unknown prize %d", ErrInvalidPool, r.Name, id)
 			}
 		}
-		// 写入中奖结果
-		_, ok := prizeRes[pid]
-		if ok {
-			prizeRes[pid] += 1
+	}
+	return nil
+}
+
+// State 玩家在奖池中的保底计数（规则名 -> 连续未中次数），由调用方持久化
+type State map[string]int
+
+// Clone 复制状态，用于重放校验
+func (s State) Clone() State {
+	c := make(State, len(s))
+	for k, v := range s {
+		c[k] = v
+	}
+	return c
+}
+
+// Source 随机数来源，*rand.Rand、CryptoSource、FairSource 均可
+type Source interface {
+	Uint64() uint64
+}
+
+// Result 一次抽奖结果，Roll 与 SoldOut 足以在 Replay 中重算；SoldOut 来自服务端的库存，不在校验范围内
+type Result struct {
+	PrizeID int64
+	Roll    uint64  // 本次使用的随机数
+	Pity    string  // 触发硬保底的规则名
+	SoldOut []int64 // 抽中但已售罄而排除的奖品，按 ID 升序
+}
+
+// Replay 按抽奖前的保底状态、随机数与售罄奖品重算结果，并推进 state；soldOut 按原样采信
+func (p *Pool) Replay(state State, roll uint64, soldOut []int64) (Result, error) {
+	excluded := make(map[int64]bool, len(soldOut))
+	for _, id := range soldOut {
+		excluded[id] = true
+	}
+	id, pity, ok := p.pick(state, roll, excluded)
+	if !ok {
+		return Result{}, ErrSoldOut
+	}
+	p.advance(state, id)
+	return Result{PrizeID: id, Roll: roll, Pity: pity, SoldOut: soldOut}, nil
+}
+
+type candidate struct {
+	id     int64
+	weight uint64
+}
+
+// pick 在未排除的奖品中按权重选取
+// 同一个随机数在候选集合变化（售罄排除）后重新映射，保证每次抽奖只消耗一个随机数
+func (p *Pool) pick(state State, roll uint64, excluded map[int64]bool) (int64, string, bool) {
+	var pity string
+	var targets map[int64]bool
+	for _, r := range p.Rules {
+		if r.Ceiling > 0 && state[r.Name] >= r.Ceiling-1 && p.available(r.Prizes, excluded) {
+			pity, targets = r.Name, toSet(r.Prizes)
+			break
+		}
+	}
+
+	cands := make([]candidate, 0, len(p.Prizes))
+	var total uint64
+	for _, v := range p.Prizes {
+		if excluded[v.PlayerId] || (targets != nil && !targets[v.PlayerId]) {
+			continue
+		}
+		w := uint64(v.Weight)
+		for _, r := range p.Rules {
+			if r.SoftStart > 0 && state[r.Name] >= r.SoftStart && contains(r.Prizes, v.PlayerId) {
+				w += uint64(v.Weight) * uint64(r.SoftStep) * uint64(state[r.Name]-r.SoftStart+1)
+			}
+		}
+		cands = append(cands, candidate{id: v.PlayerId, weight: w})
+		total += w
+	}
+	if total == 0 {
+		return 0, "", false
+	}
+
+	// roll/2^64 * total，偏差不超过 total/2^64
+	n, _ := bits.Mul64(roll, total)
+	for _, c := range cands {
+		if n < c.weight {
+			return c.id, pity, true
+		}
+		n -= c.weight
+	}
+	return cands[len(cands)-1].id, pity, true
+}
+
+func (p *Pool) available(ids []int64, excluded map[int64]bool) bool {
+	for _, id := range ids {
+		if !excluded[id] {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Pool) advance(state State, id int64) {
+	for _, r := range p.Rules {
+		if contains(r.Prizes, id) {
+			state[r.Name] = 0
 		} else {
-			prizeRes[pid] = 1
+			state[r.Name]++
 		}
 	}
-	return prizeRes
 }
 
-// ******** 方案2 (推荐) ******
+// Engine 抽奖引擎，并发安全；库存由 Stock 保证不超卖
+type Engine struct {
+	pool  Pool
+	stock Stock
+}
 
-// GetRandPrize 随机获奖 输入盲盒数量，输出中奖ID:数量
-func GetRandPrize(boxNum int64, prize []Prize) map[int64]int64 {
-	p := make(map[int64]int64, boxNum)
+// NewEngine 新建引擎，stock 为 nil 时使用按奖池初始库存的内存库存
+func NewEngine(pool Pool, stock Stock) (*Engine, error) {
+	if err := pool.Validate(); err != nil {
+		return nil, err
+	}
+	if stock == nil {
+		stock = NewMemoryStock(pool.Prizes)
+	}
+	return &Engine{pool: pool, stock: stock}, nil
+}
+
+// Pool 奖池配置
+func (e *Engine) Pool() Pool {
+	return e.pool
+}
 
-	for i := 0; i < int(boxNum); i++ {
-		// 解析将池
-		var wrs []wr.Choice
-		for _, v := range prize {
-			wrs = append(wrs, wr.Choice{
-				Item:   v.PlayerId,
-				Weight: uint(v.Weight),
-			})
+// Draw 抽一次，成功后推进 state；所有奖品售罄时返回 ErrSoldOut，state 不变
+func (e *Engine) Draw(ctx context.Context, state State, src Source) (Result, error) {
+	roll := src.Uint64()
+	excluded := map[int64]bool{}
+	for {
+		id, pity, ok := e.pool.pick(state, roll, excluded)
+		if !ok {
+			return Result{}, ErrSoldOut
 		}
-		result := RandPick(wrs)
-		p[result] += 1
-		// 更新将池
-		for i, v := range prize {
-			if v.PlayerId == result {
-				prize[i].Weight -= 1
-			}
+		taken, err := e.stock.Take(ctx, id)
+		if err != nil {
+			return Result{}, err
+		}
+		if !taken {
+			excluded[id] = true
+			continue
+		}
+
+		e.pool.advance(state, id)
+		res := Result{PrizeID: id, Roll: roll, Pity: pity}
+		for id := range excluded {
+			res.SoldOut = append(res.SoldOut, id)
 		}
+		sort.Slice(res.SoldOut, func(i, j int) bool { return res.SoldOut[i] < res.SoldOut[j] })
+		return res, nil
 	}
+}
+
+// DrawN 连抽 n 次，中途出错时返回已抽中的结果与错误
+func (e *Engine) DrawN(ctx context.Context, state State, src Source, n int) ([]Result, error) {
+	results := make([]Result, 0, n)
+	for i := 0; i < n; i++ {
+		res, err := e.Draw(ctx, state, src)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
 
-	return p
+func toSet(ids []int64) map[int64]bool {
+	s := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		s[id] = true
+	}
+	return s
 }
 
-// RandPick 传入将池，返回中奖item
-func RandPick(wrs []wr.Choice) int64 {
-	rand.Seed(time.Now().UTC().UnixNano())
-	chooser, _ := wr.NewChooser(wrs...)
-	return chooser.Pick().(int64)
+func contains(ids []int64, id int64) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,226 @@
+package mystery
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// chiSquare 卡方统计量
+func chiSquare(observed map[int64]int, expected map[int64]float64) float64 {
+	var x float64
+	for id, e := range expected {
+		d := float64(observed[id]) - e
+		x += d * d / e
+	}
+	return x
+}
+
+func TestDistribution(t *testing.T) {
+	pool := Pool{Prizes: []Prize{
+		{PlayerId: 1, Weight: 1, Stock: Unlimited},
+		{PlayerId: 2, Weight: 2, Stock: Unlimited},
+		{PlayerId: 3, Weight: 3, Stock: Unlimited},
+		{PlayerId: 4, Weight: 94, Stock: Unlimited},
+	}}
+	e, err := NewEngine(pool, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const n = 200000
+	src := rand.New(rand.NewSource(1))
+	observed := map[int64]int{}
+	for i := 0; i < n; i++ {
+		res, err := e.Draw(context.Background(), State{}, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		observed[res.PrizeID]++
+	}
+	expected := map[int64]float64{1: n * 0.01, 2: n * 0.02, 3: n * 0.03, 4: n * 0.94}
+	// 自由度 3，p = 0.001 的临界值
+	if x := chiSquare(observed, expected); x > 16.27 {
+		t.Fatalf("chi-square %.2f too large, observed %v", x, observed)
+	}
+}
+
+func TestFairSourceDistribution(t *testing.T) {
+	// 固定种子保证结果可复现
+	seed := []byte("fixed-server-seed-for-distribution-test")
+	pool := Pool{Prizes: []Prize{
+		{PlayerId: 1, Weight: 1, Stock: Unlimited},
+		{PlayerId: 2, Weight: 1, Stock: Unlimited},
+		{PlayerId: 3, Weight: 2, Stock: Unlimited},
+	}}
+	e, _ := NewEngine(pool, nil)
+
+	const n = 100000
+	src := NewFairSource(seed, "fixed-client-seed", 0)
+	observed := map[int64]int{}
+	for i := 0; i < n; i++ {
+		res, _ := e.Draw(context.Background(), State{}, src)
+		observed[res.PrizeID]++
+	}
+	expected := map[int64]float64{1: n * 0.25, 2: n * 0.25, 3: n * 0.5}
+	// 自由度 2，p = 0.001 的临界值
+	if x := chiSquare(observed, expected); x > 13.82 {
+		t.Fatalf("chi-square %.2f too large, observed %v", x, observed)
+	}
+}
+
+func TestStockNeverOversold(t *testing.T) {
+	pool := Pool{Prizes: []Prize{
+		{PlayerId: 1, Weight: 50, Stock: 3},
+		{PlayerId: 2, Weight: 50, Stock: 5},
+	}}
+	e, _ := NewEngine(pool, nil)
+
+	var (
+		wg    sync.WaitGroup
+		count [3]int64
+		fails int64
+	)
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(seed int64) {
+			defer wg.Done()
+			src := NewFairSource([]byte{byte(seed)}, "c", 0)
+			for i := 0; i < 5; i++ {
+				res, err := e.Draw(context.Background(), State{}, src)
+				if errors.Is(err, ErrSoldOut) {
+					atomic.AddInt64(&fails, 1)
+					continue
+				}
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				atomic.AddInt64(&count[res.PrizeID], 1)
+			}
+		}(int64(g))
+	}
+	wg.Wait()
+
+	if count[1] != 3 || count[2] != 5 || fails != 40-8 {
+		t.Fatalf("prize1=%d prize2=%d soldout=%d", count[1], count[2], fails)
+	}
+}
+
+func TestPityCeiling(t *testing.T) {
+	pool := Pool{
+		Prizes: []Prize{
+			{PlayerId: 1, Weight: 1, Stock: Unlimited},
+			{PlayerId: 2, Weight: 999, Stock: Unlimited},
+		},
+		Rules: []PityRule{{Name: "rare", Prizes: []int64{1}, Ceiling: 10}},
+	}
+	e, _ := NewEngine(pool, nil)
+
+	state := State{}
+	src := rand.New(rand.NewSource(2))
+	misses, hits := 0, 0
+	for i := 0; i < 1000; i++ {
+		res, err := e.Draw(context.Background(), state, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.PrizeID == 1 {
+			misses = 0
+			hits++
+			continue
+		}
+		misses++
+		if misses >= 10 {
+			t.Fatalf("draw %d: %d consecutive misses", i, misses)
+		}
+	}
+	if hits < 100 {
+		t.Fatalf("ceiling 10 should hit at least 100 times in 1000 draws, got %d", hits)
+	}
+}
+
+func TestSoftPityIncreasesRate(t *testing.T) {
+	prizes := []Prize{
+		{PlayerId: 1, Weight: 1, Stock: Unlimited},
+		{PlayerId: 2, Weight: 99, Stock: Unlimited},
+	}
+	rate := func(rules []PityRule) float64 {
+		e, _ := NewEngine(Pool{Prizes: prizes, Rules: rules}, nil)
+		state, src, hits := State{}, rand.New(rand.NewSource(3)), 0
+		for i := 0; i < 20000; i++ {
+			res, _ := e.Draw(context.Background(), state, src)
+			if res.PrizeID == 1 {
+				hits++
+			}
+		}
+		return float64(hits) / 20000
+	}
+	base := rate(nil)
+	soft := rate([]PityRule{{Name: "rare", Prizes: []int64{1}, SoftStart: 20, SoftStep: 5}})
+	if soft <= base*1.5 {
+		t.Fatalf("soft pity rate %.4f not above base %.4f", soft, base)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	pool := Pool{
+		Prizes: []Prize{
+			{PlayerId: 1, Weight: 1, Stock: 1},
+			{PlayerId: 2, Weight: 10, Stock: 20},
+			{PlayerId: 3, Weight: 100, Stock: Unlimited},
+		},
+		Rules: []PityRule{{Name: "rare", Prizes: []int64{1, 2}, Ceiling: 5}},
+	}
+	e, _ := NewEngine(pool, nil)
+	seed, _ := NewServerSeed()
+	commitment := Commit(seed)
+	src := NewFairSource(seed, "player-seed", 0)
+
+	state := State{}
+	var results []Result
+	for i := 0; i < 50; i++ {
+		res, err := e.Draw(context.Background(), state, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		results = append(results, res)
+	}
+
+	// 玩家侧：从初始状态依次重放
+	replay := State{}
+	for i, res := range results {
+		if err := Verify(pool, replay, commitment, seed, "player-seed", uint64(i), res); err != nil {
+			t.Fatalf("draw %d: %v", i, err)
+		}
+	}
+
+	tampered := results[0]
+	tampered.PrizeID = 3
+	if results[0].PrizeID == 3 {
+		tampered.PrizeID = 2
+	}
+	if err := Verify(pool, State{}, commitment, seed, "player-seed", 0, tampered); !errors.Is(err, ErrResultMismatch) {
+		t.Fatalf("expected mismatch, got %v", err)
+	}
+	if err := Verify(pool, State{}, commitment, []byte("other"), "player-seed", 0, results[0]); !errors.Is(err, ErrCommitMismatch) {
+		t.Fatalf("expected commit mismatch, got %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	bad := []Pool{
+		{},
+		{Prizes: []Prize{{PlayerId: 1, Weight: 0}}},
+		{Prizes: []Prize{{PlayerId: 1, Weight: 1}, {PlayerId: 1, Weight: 1}}},
+		{Prizes: []Prize{{PlayerId: 1, Weight: 1}}, Rules: []PityRule{{Name: "r", Prizes: []int64{2}, Ceiling: 3}}},
+	}
+	for i, p := range bad {
+		if _, err := NewEngine(p, nil); !errors.Is(err, ErrInvalidPool) {
+			t.Fatalf("pool %d: expected ErrInvalidPool, got %v", i, err)
+		}
+	}
+}
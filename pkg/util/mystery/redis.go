@@ -0,0 +1,90 @@
+package mystery
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// KEYS[1] 库存 hash；ARGV[1] 奖品ID
+// 不在 hash 中或为负数的奖品不限量；库存为 0 返回 0，否则扣减并返回 1
+var takeScript = redis.NewScript(`
+local v = redis.call('HGET', KEYS[1], ARGV[1])
+if not v then
+	return 1
+end
+v = tonumber(v)
+if v < 0 then
+	return 1
+end
+if v == 0 then
+	return 0
+end
+redis.call('HINCRBY', KEYS[1], ARGV[1], -1)
+return 1
+`)
+
+// 只归还有限库存的奖品
+var returnScript = redis.NewScript(`
+local v = redis.call('HGET', KEYS[1], ARGV[1])
+if v and tonumber(v) >= 0 then
+	redis.call('HINCRBY', KEYS[1], ARGV[1], 1)
+end
+return 0
+`)
+
+// RedisStock 基于 redis hash 的库存，多实例扣减由 Lua 脚本保证原子性
+type RedisStock struct {
+	client redis.UniversalClient
+	key    string
+}
+
+// NewRedisStock key 为奖池库存 hash，如 box:stock:{boxID}
+func NewRedisStock(client redis.UniversalClient, key string) *RedisStock {
+	return &RedisStock{client: client, key: key}
+}
+
+// Init 写入初始库存，只写入 hash 中不存在的奖品，重复调用不会覆盖已扣减的库存
+func (s *RedisStock) Init(ctx context.Context, prizes []Prize) error {
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, p := range prizes {
+			pipe.HSetNX(ctx, s.key, strconv.FormatInt(p.PlayerId, 10), p.Stock)
+		}
+		return nil
+	})
+	return err
+}
+
+func (s *RedisStock) Take(ctx context.Context, prizeID int64) (bool, error) {
+	n, err := takeScript.Run(ctx, s.client, []string{s.key}, prizeID).Int()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+func (s *RedisStock) Return(ctx context.Context, prizeID int64) error {
+	return returnScript.Run(ctx, s.client, []string{s.key}, prizeID).Err()
+}
+
+// Remaining 全部奖品剩余库存
+func (s *RedisStock) Remaining(ctx context.Context) (map[int64]int64, error) {
+	m, err := s.client.HGetAll(ctx, s.key).Result()
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[int64]int64, len(m))
+	for k, v := range m {
+		id, err := strconv.ParseInt(k, 10, 64)
+		if err != nil {
+			continue
+		}
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			continue
+		}
+		out[id] = n
+	}
+	return out, nil
+}
@@ -0,0 +1,59 @@
+package mystery
+
+import (
+	"context"
+	"sync"
+)
+
+// Stock 奖品库存
+type Stock interface {
+	// Take 扣减一个库存，已售罄返回 false；不限量的奖品总是返回 true
+	Take(ctx context.Context, prizeID int64) (bool, error)
+	// Return 归还一个库存，用于下单失败等场景回滚
+	Return(ctx context.Context, prizeID int64) error
+}
+
+// MemoryStock 单机内存库存
+type MemoryStock struct {
+	mu     sync.Mutex
+	remain map[int64]int64
+}
+
+// NewMemoryStock 按奖品初始库存创建
+func NewMemoryStock(prizes []Prize) *MemoryStock {
+	s := &MemoryStock{remain: make(map[int64]int64, len(prizes))}
+	for _, p := range prizes {
+		s.remain[p.PlayerId] = p.Stock
+	}
+	return s
+}
+
+func (s *MemoryStock) Take(_ context.Context, prizeID int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.remain[prizeID]
+	if !ok || v == Unlimited {
+		return true, nil
+	}
+	if v <= 0 {
+		return false, nil
+	}
+	s.remain[prizeID] = v - 1
+	return true, nil
+}
+
+func (s *MemoryStock) Return(_ context.Context, prizeID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.remain[prizeID]; ok && v != Unlimited {
+		s.remain[prizeID] = v + 1
+	}
+	return nil
+}
+
+// Remaining 剩余库存
+func (s *MemoryStock) Remaining(prizeID int64) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.remain[prizeID]
+}
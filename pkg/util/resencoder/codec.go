@@ -0,0 +1,250 @@
+package resencoder
+
+import (
+	"bytes"
+	commonv1 "casso/api/common/v1"
+	"encoding/json"
+	"mime"
+	ht "net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// JSONOptions protojson 编码配置；int64 按 proto3 JSON 规范编码为字符串，避免前端精度丢失
+type JSONOptions = protojson.MarshalOptions
+
+// result 待编码的响应
+type result struct {
+	err       bool
+	code      int32
+	reason    string
+	message   string
+	metadata  map[string]string
+	data      interface{}
+	requestID string
+}
+
+type codec struct {
+	contentType string
+	encode      func(e *Encoder, res *result) ([]byte, error)
+}
+
+var (
+	jsonCodec     = &codec{contentType: "application/json", encode: encodeJSON}
+	protoCodec    = &codec{contentType: "application/x-protobuf", encode: encodeProto}
+	msgpackCodec  = &codec{contentType: "application/x-msgpack", encode: encodeMsgpack}
+	codecsByMedia = map[string]*codec{
+		"application/json":        jsonCodec,
+		"application/x-protobuf":  protoCodec,
+		"application/protobuf":    protoCodec,
+		"application/x-msgpack":   msgpackCodec,
+		"application/msgpack":     msgpackCodec,
+		"application/vnd.msgpack": msgpackCodec,
+		"application/*":           jsonCodec,
+		"*/*":                     jsonCodec,
+	}
+)
+
+// negotiate 按 Accept 的 q 值选择编码，无法匹配时使用 JSON
+func (e *Encoder) negotiate(r *ht.Request) *codec {
+	type accept struct {
+		media string
+		q     float64
+		index int
+	}
+	var list []accept
+	for i, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		media, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				q = f
+			}
+		}
+		if q > 0 {
+			list = append(list, accept{media: media, q: q, index: i})
+		}
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].q > list[j].q
+	})
+	for _, a := range list {
+		if c, ok := codecsByMedia[a.media]; ok {
+			return c
+		}
+	}
+	return jsonCodec
+}
+
+// codecFor 按 Accept 协商编码，protobuf 无法表示的响应（非 proto 数据、不包装时的错误）使用 JSON，
+// Content-Type 与实际编码一致
+func (e *Encoder) codecFor(r *ht.Request, res *result) *codec {
+	c := e.negotiate(r)
+	if c != protoCodec {
+		return c
+	}
+	if res.err {
+		if !e.envelope {
+			return jsonCodec
+		}
+		return c
+	}
+	if _, ok := res.data.(proto.Message); !ok {
+		return jsonCodec
+	}
+	return c
+}
+
+// fields 按顺序输出包装结构的字段
+func (e *Encoder) fieldList(res *result, data interface{}) []field {
+	if !e.envelope {
+		return nil
+	}
+	fs := []field{
+		{e.fields.Code, res.code},
+		{e.fields.Reason, res.reason},
+		{e.fields.Message, res.message},
+		{e.fields.Data, data},
+		{e.fields.RequestID, res.requestID},
+	}
+	list := fs[:0]
+	for _, f := range fs {
+		if f.name != "" {
+			list = append(list, f)
+		}
+	}
+	return list
+}
+
+type field struct {
+	name  string
+	value interface{}
+}
+
+// errorData 错误的 data 为 kratos 错误的 metadata
+func errorData(res *result) interface{} {
+	if len(res.metadata) == 0 {
+		return nil
+	}
+	return res.metadata
+}
+
+func (e *Encoder) marshalData(v interface{}) (json.RawMessage, error) {
+	if m, ok := v.(proto.Message); ok {
+		return e.jsonOpts.Marshal(m)
+	}
+	return json.Marshal(v)
+}
+
+func encodeJSON(e *Encoder, res *result) ([]byte, error) {
+	if !e.envelope {
+		if res.err {
+			return json.Marshal(map[string]interface{}{
+				"code":     res.code,
+				"reason":   res.reason,
+				"message":  res.message,
+				"metadata": res.metadata,
+			})
+		}
+		return e.marshalData(res.data)
+	}
+
+	var data interface{} = errorData(res)
+	if !res.err {
+		raw, err := e.marshalData(res.data)
+		if err != nil {
+			return nil, err
+		}
+		data = raw
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, f := range e.fieldList(res, data) {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(f.name)
+		val, err := json.Marshal(f.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(val)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// encodeProto 响应包装为 api/common/v1.Response，业务数据放在 Any 中；非 proto 数据由 codecFor 改用 JSON
+func encodeProto(e *Encoder, res *result) ([]byte, error) {
+	m, _ := res.data.(proto.Message)
+	if !e.envelope {
+		return proto.Marshal(m)
+	}
+	out := &commonv1.Response{
+		Code:      res.code,
+		Reason:    res.reason,
+		Message:   res.message,
+		RequestId: res.requestID,
+	}
+	if !res.err {
+		data, err := anypb.New(m)
+		if err != nil {
+			return nil, err
+		}
+		out.Data = data
+	}
+	return proto.Marshal(out)
+}
+
+// encodeMsgpack proto 数据先按 protojson 规则转为通用结构，保证字段名与 JSON 一致
+func encodeMsgpack(e *Encoder, res *result) ([]byte, error) {
+	var data interface{} = errorData(res)
+	if !res.err {
+		raw, err := e.marshalData(res.data)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return nil, err
+		}
+	}
+	if !e.envelope {
+		if res.err {
+			return msgpack.Marshal(map[string]interface{}{
+				"code":     res.code,
+				"reason":   res.reason,
+				"message":  res.message,
+				"metadata": res.metadata,
+			})
+		}
+		return msgpack.Marshal(data)
+	}
+
+	var buf bytes.Buffer
+	enc := msgpack.NewEncoder(&buf)
+	fs := e.fieldList(res, data)
+	if err := enc.EncodeMapLen(len(fs)); err != nil {
+		return nil, err
+	}
+	for _, f := range fs {
+		if err := enc.EncodeString(f.name); err != nil {
+			return nil, err
+		}
+		if err := enc.Encode(f.value); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
@@ -1,6 +1,10 @@
 /*
  * @PackageName: resencoder
  * @Description: 自定义返回数据编码方式
+ * 根据 Accept 协商 JSON(protojson)、protobuf、msgpack，成功与失败统一包装为
+ * {code, reason, message, data, request_id}：
+ *   enc := resencoder.New()
+ *   http.ResponseEncoder(enc.Response()), http.ErrorEncoder(enc.Error())
  * @Author: Casso-Wong
  * @Date: 2021-10-29 12:32:52
  * @Last Modified by: Casso-Wong
@@ -12,13 +16,166 @@ import (
 	"encoding/json"
 	ht "net/http"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/transport/http"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// Fields 包装结构的字段名，用于 JSON 与 msgpack
+type Fields struct {
+	Code      string
+	Reason    string
+	Message   string
+	Data      string
+	RequestID string
+}
+
+// Encoder 响应编码器
+type Encoder struct {
+	fields      Fields
+	envelope    bool
+	successCode int32
+	successMsg  string
+	requestID   func(r *ht.Request) string
+	jsonOpts    JSONOptions
+}
+
+// Option 编码器配置
+type Option func(*Encoder)
+
+// WithFields 自定义包装结构的字段名，空字符串表示不输出该字段
+func WithFields(f Fields) Option {
+	return func(e *Encoder) {
+		e.fields = f
+	}
+}
+
+// WithoutEnvelope 不包装，直接返回业务数据；错误使用 kratos 默认结构
+func WithoutEnvelope() Option {
+	return func(e *Encoder) {
+		e.envelope = false
+	}
+}
+
+// WithSuccess 成功时的 code 与 message，默认 0 与 "ok"
+func WithSuccess(code int32, message string) Option {
+	return func(e *Encoder) {
+		e.successCode = code
+		e.successMsg = message
+	}
+}
+
+// WithRequestID 自定义请求ID，默认取 X-Request-Id 请求头，没有时使用链路追踪的 trace id
+func WithRequestID(fn func(r *ht.Request) string) Option {
+	return func(e *Encoder) {
+		e.requestID = fn
+	}
+}
+
+// WithJSONOptions protojson 编码配置
+func WithJSONOptions(o JSONOptions) Option {
+	return func(e *Encoder) {
+		e.jsonOpts = o
+	}
+}
+
+// New 新建编码器
+func New(opts ...Option) *Encoder {
+	e := &Encoder{
+		fields: Fields{
+			Code:      "code",
+			Reason:    "reason",
+			Message:   "message",
+			Data:      "data",
+			RequestID: "request_id",
+		},
+		envelope:   true,
+		successMsg: "ok",
+		requestID:  defaultRequestID,
+		jsonOpts:   JSONOptions{UseProtoNames: true, EmitUnpopulated: true},
+	}
+	for _, o := range opts {
+		o(e)
+	}
+	return e
+}
+
+// Response 成功响应编码器
+func (e *Encoder) Response() http.EncodeResponseFunc {
+	return func(w ht.ResponseWriter, r *ht.Request, v interface{}) error {
+		if v == nil {
+			return nil
+		}
+		res := &result{data: v}
+		c := e.codecFor(r, res)
+		if e.envelope {
+			res.code = e.successCode
+			res.message = e.successMsg
+			res.requestID = e.requestID(r)
+		}
+		body, err := c.encode(e, res)
+		if err != nil {
+			return err
+		}
+		w.Header().Set("Content-Type", c.contentType)
+		_, err = w.Write(body)
+		return err
+	}
+}
+
+// Error 错误编码器，与 Response 使用相同的结构，HTTP 状态码取 kratos 错误码
+func (e *Encoder) Error() http.EncodeErrorFunc {
+	return func(w ht.ResponseWriter, r *ht.Request, err error) {
+		se := errors.FromError(err)
+		res := &result{
+			err:       true,
+			code:      se.Code,
+			reason:    se.Reason,
+			message:   se.Message,
+			metadata:  se.Metadata,
+			requestID: e.requestID(r),
+		}
+		c := e.codecFor(r, res)
+		status := statusCode(se.Code)
+		body, merr := c.encode(e, res)
+		if merr != nil {
+			// 编码失败时返回最简的 JSON 错误，客户端至少能拿到错误原因
+			c, status = jsonCodec, ht.StatusInternalServerError
+			body, _ = json.Marshal(map[string]interface{}{
+				"code":    ht.StatusInternalServerError,
+				"reason":  se.Reason,
+				"message": se.Message,
+			})
+		}
+		w.Header().Set("Content-Type", c.contentType)
+		w.WriteHeader(status)
+		w.Write(body)
+	}
+}
+
+// statusCode kratos 错误码不是合法的 HTTP 状态码时返回 500
+func statusCode(code int32) int {
+	if code < 100 || code > 599 {
+		return ht.StatusInternalServerError
+	}
+	return int(code)
+}
+
+func defaultRequestID(r *ht.Request) string {
+	if id := r.Header.Get("X-Request-Id"); id != "" {
+		return id
+	}
+	if sc := trace.SpanContextFromContext(r.Context()); sc.HasTraceID() {
+		return sc.TraceID().String()
+	}
+	return ""
+}
+
+// ResponeJsonDeco 使用 encoding/json 编码，不设置 Content-Type
+//
+// Deprecated: 使用 New().Response()，与 New().Error() 配合返回统一结构
 func ResponeJsonDeco() http.EncodeResponseFunc {
 	return func(w ht.ResponseWriter, r *ht.Request, v interface{}) error {
-		// codec, _ := http.CodecForRequest(r, "Accept")
-		// data, err := codec.Marshal(v)
 		data, err := json.Marshal(v) // 指定json 序列化方式
 		if err != nil {
 			return err
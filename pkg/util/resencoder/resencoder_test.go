@@ -0,0 +1,102 @@
+package resencoder
+
+import (
+	commonv1 "casso/api/common/v1"
+	"encoding/json"
+	ht "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/proto"
+)
+
+func request(accept string) *ht.Request {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("Accept", accept)
+	r.Header.Set("X-Request-Id", "req-1")
+	return r
+}
+
+func TestResponseJSON(t *testing.T) {
+	w := httptest.NewRecorder()
+	err := New().Response()(w, request("text/html, application/json;q=0.9"), &commonv1.Money{Amount: 1234, Currency: "CNY"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/json" {
+		t.Fatalf("content type = %s", ct)
+	}
+	want := `{"code":0,"reason":"","message":"ok","data":{"amount":"1234","currency":"CNY"},"request_id":"req-1"}`
+	if w.Body.String() != want {
+		t.Fatalf("body = %s", w.Body.String())
+	}
+}
+
+func TestResponseProto(t *testing.T) {
+	w := httptest.NewRecorder()
+	err := New().Response()(w, request("application/x-protobuf"), &commonv1.Money{Amount: 1, Currency: "CNY"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var res commonv1.Response
+	if err := proto.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	var m commonv1.Money
+	if err := res.Data.UnmarshalTo(&m); err != nil || m.Amount != 1 || res.RequestId != "req-1" {
+		t.Fatalf("res = %v, money = %v, err = %v", &res, &m, err)
+	}
+}
+
+func TestResponseMsgpack(t *testing.T) {
+	w := httptest.NewRecorder()
+	enc := New(WithFields(Fields{Code: "errcode", Message: "msg", Data: "result"}))
+	if err := enc.Response()(w, request("application/x-msgpack"), &commonv1.Money{Amount: 1, Currency: "CNY"}); err != nil {
+		t.Fatal(err)
+	}
+	var res map[string]interface{}
+	if err := msgpack.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 3 || res["msg"] != "ok" {
+		t.Fatalf("res = %v", res)
+	}
+	if data := res["result"].(map[string]interface{}); data["amount"] != "1" {
+		t.Fatalf("data = %v", data)
+	}
+}
+
+func TestErrorEncoder(t *testing.T) {
+	w := httptest.NewRecorder()
+	err := errors.New(404, "USER_NOT_FOUND", "用户不存在")
+	New().Error()(w, request(""), err)
+	if w.Code != 404 {
+		t.Fatalf("status = %d", w.Code)
+	}
+	var res map[string]interface{}
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res["code"].(float64) != 404 || res["reason"] != "USER_NOT_FOUND" || res["data"] != nil || res["request_id"] != "req-1" {
+		t.Fatalf("res = %v", res)
+	}
+}
+
+func TestProtoFallbackContentType(t *testing.T) {
+	// 非 proto 数据与不包装时的错误按 JSON 编码，Content-Type 也要是 JSON
+	w := httptest.NewRecorder()
+	if err := New().Response()(w, request("application/x-protobuf"), map[string]string{"k": "v"}); err != nil {
+		t.Fatal(err)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/json" || !json.Valid(w.Body.Bytes()) {
+		t.Fatalf("content type = %s, body = %s", ct, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	New(WithoutEnvelope()).Error()(w, request("application/x-protobuf"), errors.New(404, "USER_NOT_FOUND", "用户不存在"))
+	if ct := w.Header().Get("Content-Type"); ct != "application/json" || w.Code != 404 || !json.Valid(w.Body.Bytes()) {
+		t.Fatalf("status = %d, content type = %s, body = %s", w.Code, ct, w.Body.String())
+	}
+}
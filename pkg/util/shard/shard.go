@@ -0,0 +1,62 @@
+/*
+ * @PackageName: shard
+ * @Description: 分片路由，使用 jump consistent hash（Lamping & Veach）
+ * 分片数从 n 增加到 m 时，只有约 (m-n)/m 的数据需要迁移，且只会迁往新增的分片
+ */
+package shard
+
+import "fmt"
+
+// JumpHash 返回 key 所在的分片，范围 [0, buckets)
+func JumpHash(key uint64, buckets int) int {
+	var b, j int64 = -1, 0
+	for j < int64(buckets) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return int(b)
+}
+
+// Router 按 ID 路由到分表
+type Router struct {
+	table  string
+	shards int
+}
+
+// NewRouter 新建分表路由，分表名为 {table}_{序号}，如 user_00
+func NewRouter(table string, shards int) *Router {
+	if shards < 1 {
+		shards = 1
+	}
+	return &Router{table: table, shards: shards}
+}
+
+// Shards 分片数量
+func (r *Router) Shards() int {
+	return r.shards
+}
+
+// Shard 返回 id 所在的分片序号
+func (r *Router) Shard(id int64) int {
+	return JumpHash(uint64(id), r.shards)
+}
+
+// Table 返回 id 所在的分表名
+func (r *Router) Table(id int64) string {
+	return r.TableAt(r.Shard(id))
+}
+
+// TableAt 返回指定序号的分表名
+func (r *Router) TableAt(shard int) string {
+	return fmt.Sprintf("%s_%02d", r.table, shard)
+}
+
+// Tables 返回全部分表名
+func (r *Router) Tables() []string {
+	tables := make([]string, r.shards)
+	for i := range tables {
+		tables[i] = r.TableAt(i)
+	}
+	return tables
+}
@@ -0,0 +1,42 @@
+package shard
+
+import "testing"
+
+func TestJumpHashBalance(t *testing.T) {
+	const buckets, keys = 16, 160000
+	counts := make([]int, buckets)
+	// 模拟雪花ID：时间戳左移 22 位，低位为序列号
+	for i := 0; i < keys; i++ {
+		id := uint64(1600000000000+i/64)<<22 | uint64(i%64)
+		counts[JumpHash(id, buckets)]++
+	}
+	want := keys / buckets
+	for i, c := range counts {
+		if c < want*9/10 || c > want*11/10 {
+			t.Fatalf("bucket %d has %d keys, want about %d", i, c, want)
+		}
+	}
+}
+
+func TestJumpHashMinimalMove(t *testing.T) {
+	for key := uint64(0); key < 10000; key++ {
+		from, to := JumpHash(key, 8), JumpHash(key, 12)
+		// 扩容时数据要么留在原分片，要么迁往新增的分片
+		if from != to && to < 8 {
+			t.Fatalf("key %d moved from %d to existing shard %d", key, from, to)
+		}
+	}
+}
+
+func TestRouterTable(t *testing.T) {
+	r := NewRouter("user", 16)
+	if got := r.TableAt(3); got != "user_03" {
+		t.Fatalf("TableAt(3) = %s", got)
+	}
+	if len(r.Tables()) != 16 {
+		t.Fatalf("got %d tables", len(r.Tables()))
+	}
+	if r.Table(42) != r.TableAt(r.Shard(42)) {
+		t.Fatal("Table and Shard disagree")
+	}
+}
@@ -0,0 +1,144 @@
+package snowflake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Leaser 节点ID租约，保证同一时刻每个节点ID只被一个实例持有
+type Leaser interface {
+	// Acquire 租用一个空闲节点ID，返回该ID上一任持有者最后发号的 Unix 毫秒时间戳
+	Acquire(ctx context.Context) (node int64, last int64, err error)
+	// Renew 续期并记录最后发号时间戳，租约已被他人持有时返回 ErrLeaseLost
+	Renew(ctx context.Context, node int64, last int64) error
+	// Release 释放租约
+	Release(ctx context.Context, node int64, last int64) error
+}
+
+// Generator 基于租约的发号器，后台定期续期，租约过期后拒绝发号
+type Generator struct {
+	node   *Node
+	leaser Leaser
+	ttl    time.Duration
+
+	mu       sync.RWMutex
+	deadline time.Time
+	lost     bool
+
+	onError func(error)
+	stop    chan struct{}
+	done    chan struct{}
+	once    sync.Once
+}
+
+// GeneratorOption 发号器配置
+type GeneratorOption func(*Generator)
+
+// WithTTL 租约有效期，每 ttl/3 续期一次，默认 30s
+func WithTTL(ttl time.Duration) GeneratorOption {
+	return func(g *Generator) {
+		g.ttl = ttl
+	}
+}
+
+// WithOnError 续期失败回调，用于记录日志
+func WithOnError(fn func(error)) GeneratorOption {
+	return func(g *Generator) {
+		g.onError = fn
+	}
+}
+
+// NewGenerator 租用节点ID并启动续期，没有可用节点ID或时钟落后于上一任持有者时返回错误
+func NewGenerator(ctx context.Context, leaser Leaser, opts []GeneratorOption, nodeOpts ...NodeOption) (*Generator, error) {
+	g := &Generator{
+		leaser: leaser,
+		ttl:    30 * time.Second,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	for _, o := range opts {
+		o(g)
+	}
+
+	id, last, err := leaser.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	g.deadline = time.Now().Add(g.ttl)
+
+	node, err := NewNode(id, append(nodeOpts, WithLastTimestamp(last))...)
+	if err == nil && last > 0 && time.Now().UnixNano()/1e6+node.maxBackward < last {
+		err = fmt.Errorf("%w: node %d was last used at %d", ErrClockBackwards, id, last)
+	}
+	if err != nil {
+		leaser.Release(ctx, id, last)
+		return nil, err
+	}
+	g.node = node
+
+	go g.heartbeat()
+	return g, nil
+}
+
+// NodeID 当前持有的节点ID
+func (g *Generator) NodeID() int64 {
+	return g.node.NodeID()
+}
+
+// NextID 生成ID，租约丢失或过期时返回 ErrLeaseLost
+func (g *Generator) NextID() (int64, error) {
+	g.mu.RLock()
+	valid := !g.lost && time.Now().Before(g.deadline)
+	g.mu.RUnlock()
+	if !valid {
+		return 0, ErrLeaseLost
+	}
+	return g.node.NextID()
+}
+
+func (g *Generator) heartbeat() {
+	defer close(g.done)
+	ticker := time.NewTicker(g.ttl / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-g.stop:
+			return
+		case <-ticker.C:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), g.ttl/3)
+		start := time.Now()
+		err := g.leaser.Renew(ctx, g.node.NodeID(), g.node.LastTimestamp())
+		cancel()
+
+		g.mu.Lock()
+		switch {
+		case err == nil:
+			g.deadline = start.Add(g.ttl)
+		case errors.Is(err, ErrLeaseLost):
+			g.lost = true
+		}
+		g.mu.Unlock()
+		if err != nil && g.onError != nil {
+			g.onError(err)
+		}
+	}
+}
+
+// Close 停止续期并释放租约
+func (g *Generator) Close(ctx context.Context) error {
+	var err error
+	g.once.Do(func() {
+		close(g.stop)
+		<-g.done
+		g.mu.Lock()
+		g.lost = true
+		g.mu.Unlock()
+		err = g.leaser.Release(ctx, g.node.NodeID(), g.node.LastTimestamp())
+	})
+	return err
+}
@@ -0,0 +1,104 @@
+package snowflake
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// KEYS[1] 租约 KEYS[2] 最后发号时间戳；ARGV[1] 持有者 ARGV[2] 有效期(ms) ARGV[3] 最后发号时间戳
+// 租约空闲或属于自己时（重新）占用，返回上一任持有者最后发号时间戳；被他人持有时返回 -1
+var acquireScript = redis.NewScript(`
+local owner = redis.call('GET', KEYS[1])
+if owner and owner ~= ARGV[1] then
+	return -1
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+local last = tonumber(redis.call('GET', KEYS[2]) or '0')
+local cur = tonumber(ARGV[3])
+if cur > last then
+	redis.call('SET', KEYS[2], ARGV[3])
+	last = cur
+end
+return last
+`)
+
+// 仅持有者可以释放
+var releaseScript = redis.NewScript(`
+local last = tonumber(redis.call('GET', KEYS[2]) or '0')
+if tonumber(ARGV[2]) > last then
+	redis.call('SET', KEYS[2], ARGV[2])
+end
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// RedisLeaser 基于 redis 的节点ID租约
+type RedisLeaser struct {
+	client redis.UniversalClient
+	prefix string
+	owner  string
+	ttl    time.Duration
+}
+
+// NewRedisLeaser 新建租约，prefix 区分不同服务的节点ID空间，ttl 需与 WithTTL 一致
+func NewRedisLeaser(client redis.UniversalClient, prefix string, ttl time.Duration) *RedisLeaser {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return &RedisLeaser{
+		client: client,
+		prefix: prefix,
+		owner:  hex.EncodeToString(b),
+		ttl:    ttl,
+	}
+}
+
+func (l *RedisLeaser) keys(node int64) []string {
+	return []string{
+		fmt.Sprintf("%s:node:%d", l.prefix, node),
+		fmt.Sprintf("%s:last:%d", l.prefix, node),
+	}
+}
+
+// Acquire 从随机位置开始依次尝试占用节点ID，避免多个实例同时启动时争抢同一个ID
+func (l *RedisLeaser) Acquire(ctx context.Context) (int64, int64, error) {
+	start, err := rand.Int(rand.Reader, big.NewInt(MaxNode+1))
+	if err != nil {
+		return 0, 0, err
+	}
+	for i := int64(0); i <= MaxNode; i++ {
+		node := (start.Int64() + i) % (MaxNode + 1)
+		last, err := acquireScript.Run(ctx, l.client, l.keys(node), l.owner, l.ttl.Milliseconds(), 0).Int64()
+		if err != nil {
+			return 0, 0, err
+		}
+		if last >= 0 {
+			return node, last, nil
+		}
+	}
+	return 0, 0, fmt.Errorf("snowflake: no free node id under %q", l.prefix)
+}
+
+// Renew 续期，租约过期但未被占用时重新占用
+func (l *RedisLeaser) Renew(ctx context.Context, node int64, last int64) error {
+	res, err := acquireScript.Run(ctx, l.client, l.keys(node), l.owner, l.ttl.Milliseconds(), last).Int64()
+	if err != nil {
+		return err
+	}
+	if res < 0 {
+		return ErrLeaseLost
+	}
+	return nil
+}
+
+// Release 释放租约并记录最后发号时间戳
+func (l *RedisLeaser) Release(ctx context.Context, node int64, last int64) error {
+	return releaseScript.Run(ctx, l.client, l.keys(node), l.owner, last).Err()
+}
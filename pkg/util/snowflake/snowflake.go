@@ -4,25 +4,141 @@
  * @LastEditors: Casso
  * @LastEditTime: 2021-11-19 16:41:04
  * @Description: 雪花发号器
+ * ID 布局与 github.com/bwmarrin/snowflake 一致：41 位毫秒时间戳 | 10 位节点ID | 12 位序列号
+ * 多副本部署时节点ID通过租约分配（见 lease.go），单机或测试可使用静态节点ID
  * @FilePath: /kratos-mono-repo/pkg/util/snowflake/snowflake.go
  */
 package snowflake
 
 import (
-	"github.com/bwmarrin/snowflake"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
 )
 
-var node *snowflake.Node = nil
+const (
+	// Epoch 2010-11-04 01:42:54 UTC，与 bwmarrin/snowflake 一致，保证已生成的ID不冲突
+	Epoch int64 = 1288834974657
 
-func init() {
-	if nodes, err := snowflake.NewNode(1); err != nil {
-		panic("snowflake init faild")
+	NodeBits uint8 = 10
+	StepBits uint8 = 12
+
+	MaxNode  int64 = -1 ^ (-1 << NodeBits)
+	stepMask int64 = -1 ^ (-1 << StepBits)
+
+	timeShift = NodeBits + StepBits
+	nodeShift = StepBits
+)
+
+var (
+	// ErrClockBackwards 时钟回拨超过容忍范围
+	ErrClockBackwards = errors.New("snowflake: clock moved backwards")
+	// ErrLeaseLost 节点ID租约丢失，继续发号可能与其他副本冲突
+	ErrLeaseLost = errors.New("snowflake: node id lease lost")
+)
+
+// IDGenerator 发号器，由调用方注入
+type IDGenerator interface {
+	NextID() (int64, error)
+}
+
+// Node 单个节点的发号器
+type Node struct {
+	mu          sync.Mutex
+	node        int64
+	last        int64 // 最近一次发号的时间戳（相对 Epoch 的毫秒）
+	step        int64
+	maxBackward int64
+	now         func() int64
+}
+
+// NodeOption 节点配置
+type NodeOption func(*Node)
+
+// WithMaxBackward 可容忍的时钟回拨，回拨在范围内时等待时钟追上，超出时返回 ErrClockBackwards，默认 10ms
+func WithMaxBackward(d time.Duration) NodeOption {
+	return func(n *Node) {
+		n.maxBackward = int64(d / time.Millisecond)
+	}
+}
+
+// WithLastTimestamp 上一个使用该节点ID的实例最后发号的 Unix 毫秒时间戳，
+// 新实例只会生成更大时间戳的ID，防止节点ID易主或重启后时钟回拨产生重复ID
+func WithLastTimestamp(ms int64) NodeOption {
+	return func(n *Node) {
+		if ms > Epoch {
+			n.last = ms - Epoch
+		}
+	}
+}
+
+// NewNode 新建节点，node 取值 [0, MaxNode]
+func NewNode(node int64, opts ...NodeOption) (*Node, error) {
+	if node < 0 || node > MaxNode {
+		return nil, fmt.Errorf("snowflake: node id must be between 0 and %d", MaxNode)
+	}
+	n := &Node{
+		node:        node,
+		maxBackward: 10,
+		now: func() int64 {
+			return time.Now().UnixNano()/1e6 - Epoch
+		},
+	}
+	for _, o := range opts {
+		o(n)
+	}
+	return n, nil
+}
+
+// NodeID 节点ID
+func (n *Node) NodeID() int64 {
+	return n.node
+}
+
+// LastTimestamp 最后发号的 Unix 毫秒时间戳，租约续期时持久化
+func (n *Node) LastTimestamp() int64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.last == 0 {
+		return 0
+	}
+	return n.last + Epoch
+}
+
+// NextID 生成ID
+func (n *Node) NextID() (int64, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	now := n.now()
+	if now < n.last {
+		if n.last-now > n.maxBackward {
+			return 0, fmt.Errorf("%w: %dms", ErrClockBackwards, n.last-now)
+		}
+		for now < n.last {
+			time.Sleep(time.Duration(n.last-now) * time.Millisecond)
+			now = n.now()
+		}
+	}
+
+	if now == n.last {
+		n.step = (n.step + 1) & stepMask
+		if n.step == 0 {
+			// 当前毫秒序列号用尽，等待下一毫秒
+			for now <= n.last {
+				now = n.now()
+			}
+		}
 	} else {
-		node = nodes
+		n.step = 0
 	}
+	n.last = now
+
+	return now<<timeShift | n.node<<nodeShift | n.step, nil
 }
 
-// RandomUID 简易发号器
-func RandomUID() int64 {
-	return node.Generate().Int64()
+// ParseID 解析ID，返回 Unix 毫秒时间戳、节点ID与序列号
+func ParseID(id int64) (ms, node, step int64) {
+	return id>>timeShift + Epoch, id >> nodeShift & MaxNode, id & stepMask
 }
@@ -9,10 +9,139 @@
 package snowflake
 
 import (
-	"fmt"
+	"context"
+	"errors"
+	"sync"
 	"testing"
+	"time"
 )
 
-func TestNewSnow(t *testing.T) {
-	fmt.Println(RandomUID()) // go test -v snowflake_test.go snowflake.go
+func TestNextID(t *testing.T) {
+	n, err := NewNode(7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := make(map[int64]bool)
+	var prev int64
+	for i := 0; i < 10000; i++ {
+		id, err := n.NextID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seen[id] || id <= prev {
+			t.Fatalf("id %d is not unique and increasing", id)
+		}
+		seen[id] = true
+		prev = id
+	}
+	if _, node, _ := ParseID(prev); node != 7 {
+		t.Fatalf("node = %d", node)
+	}
+}
+
+func TestClockBackwards(t *testing.T) {
+	n, _ := NewNode(1, WithMaxBackward(5*time.Millisecond))
+	now := int64(1000)
+	n.now = func() int64 { return now }
+	if _, err := n.NextID(); err != nil {
+		t.Fatal(err)
+	}
+
+	now = 900
+	if _, err := n.NextID(); !errors.Is(err, ErrClockBackwards) {
+		t.Fatalf("err = %v, want ErrClockBackwards", err)
+	}
+}
+
+func TestLastTimestamp(t *testing.T) {
+	future := time.Now().Add(time.Hour).UnixNano() / 1e6
+	n, _ := NewNode(1, WithLastTimestamp(future))
+	if _, err := n.NextID(); !errors.Is(err, ErrClockBackwards) {
+		t.Fatalf("err = %v, want ErrClockBackwards", err)
+	}
+}
+
+// memLeaser 内存租约，仅用于测试
+type memLeaser struct {
+	mu    sync.Mutex
+	held  map[int64]bool
+	last  map[int64]int64
+	renew error
+}
+
+func (l *memLeaser) Acquire(ctx context.Context) (int64, int64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for i := int64(0); i <= MaxNode; i++ {
+		if !l.held[i] {
+			l.held[i] = true
+			return i, l.last[i], nil
+		}
+	}
+	return 0, 0, errors.New("no free node")
+}
+
+func (l *memLeaser) Renew(ctx context.Context, node, last int64) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.renew
+}
+
+func (l *memLeaser) Release(ctx context.Context, node, last int64) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.held, node)
+	l.last[node] = last
+	return nil
+}
+
+func TestGeneratorLease(t *testing.T) {
+	l := &memLeaser{held: map[int64]bool{}, last: map[int64]int64{}}
+	g1, err := NewGenerator(context.Background(), l, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g2, err := NewGenerator(context.Background(), l, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g1.NodeID() == g2.NodeID() {
+		t.Fatal("generators should lease different node ids")
+	}
+	id, err := g1.NextID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	g1.Close(context.Background())
+	g2.Close(context.Background())
+	if _, err := g1.NextID(); !errors.Is(err, ErrLeaseLost) {
+		t.Fatalf("err = %v, want ErrLeaseLost after close", err)
+	}
+
+	// 重新租到同一个节点ID时从上一任的最后时间戳之后发号
+	g3, err := NewGenerator(context.Background(), l, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g3.Close(context.Background())
+	next, err := g3.NextID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ms, _, _ := ParseID(next); ms < l.last[g3.NodeID()] || next <= id {
+		t.Fatalf("id %d reissued an old timestamp", next)
+	}
+}
+
+func TestGeneratorLeaseLost(t *testing.T) {
+	l := &memLeaser{held: map[int64]bool{}, last: map[int64]int64{}, renew: ErrLeaseLost}
+	g, err := NewGenerator(context.Background(), l, []GeneratorOption{WithTTL(30 * time.Millisecond)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close(context.Background())
+	time.Sleep(40 * time.Millisecond)
+	if _, err := g.NextID(); !errors.Is(err, ErrLeaseLost) {
+		t.Fatalf("err = %v, want ErrLeaseLost", err)
+	}
 }
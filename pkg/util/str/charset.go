@@ -0,0 +1,301 @@
+package str
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"mime"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+)
+
+// Charset 文本编码，取值为 Content-Type 中常用的小写名称
+type Charset string
+
+const (
+	Unknown Charset = ""
+	UTF8    Charset = "utf-8"
+	GBK     Charset = "gbk"
+	GB18030 Charset = "gb18030"
+	BIG5    Charset = "big5"
+)
+
+// detectSize NewDetectReader 用于探测编码的字节数
+const detectSize = 4096
+
+// ErrUnknownCharset 不支持的编码
+var ErrUnknownCharset = errors.New("str: unknown charset")
+
+var utf8BOM = []byte{0xef, 0xbb, 0xbf}
+
+// 编码别名，GB2312 与 CP936 按 GBK 处理
+var charsetAliases = map[string]Charset{
+	"utf-8":       UTF8,
+	"utf8":        UTF8,
+	"gbk":         GBK,
+	"gb2312":      GBK,
+	"cp936":       GBK,
+	"x-gbk":       GBK,
+	"windows-936": GBK,
+	"gb18030":     GB18030,
+	"big5":        BIG5,
+	"big-5":       BIG5,
+	"cp950":       BIG5,
+	"big5-hkscs":  BIG5,
+}
+
+// ParseCharset 解析编码名称，大小写不敏感
+func ParseCharset(name string) (Charset, error) {
+	if cs, ok := charsetAliases[strings.ToLower(strings.TrimSpace(name))]; ok {
+		return cs, nil
+	}
+	return Unknown, ErrUnknownCharset
+}
+
+// CharsetFromContentType 取 Content-Type 中的 charset 参数，未声明时返回 Unknown
+func CharsetFromContentType(contentType string) (Charset, error) {
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil || params["charset"] == "" {
+		return Unknown, nil
+	}
+	return ParseCharset(params["charset"])
+}
+
+func (c Charset) encoding() (encoding.Encoding, error) {
+	switch c {
+	case UTF8:
+		return unicode.UTF8, nil
+	case GBK:
+		return simplifiedchinese.GBK, nil
+	case GB18030:
+		return simplifiedchinese.GB18030, nil
+	case BIG5:
+		return traditionalchinese.Big5, nil
+	}
+	return nil, ErrUnknownCharset
+}
+
+func (c Charset) decoder() (transform.Transformer, error) {
+	if c == UTF8 {
+		// 去掉 UTF-8 BOM，非法字节替换为 U+FFFD
+		return unicode.BOMOverride(unicode.UTF8.NewDecoder()), nil
+	}
+	enc, err := c.encoding()
+	if err != nil {
+		return nil, err
+	}
+	return enc.NewDecoder(), nil
+}
+
+// NewDecoder 将 c 编码的 r 转换为 UTF-8
+func NewDecoder(r io.Reader, c Charset) (io.Reader, error) {
+	t, err := c.decoder()
+	if err != nil {
+		return nil, err
+	}
+	return transform.NewReader(r, t), nil
+}
+
+// NewEncoder 将写入的 UTF-8 转换为 c 编码后写入 w，写完后需要 Close 刷出缓冲的数据；
+// 目标编码无法表示的字符返回错误
+func NewEncoder(w io.Writer, c Charset) (io.WriteCloser, error) {
+	enc, err := c.encoding()
+	if err != nil {
+		return nil, err
+	}
+	return transform.NewWriter(w, enc.NewEncoder()), nil
+}
+
+// Decode 将 c 编码的 data 转换为 UTF-8
+func Decode(data []byte, c Charset) ([]byte, error) {
+	t, err := c.decoder()
+	if err != nil {
+		return nil, err
+	}
+	out, _, err := transform.Bytes(t, data)
+	return out, err
+}
+
+// Encode 将 UTF-8 的 data 转换为 c 编码
+func Encode(data []byte, c Charset) ([]byte, error) {
+	enc, err := c.encoding()
+	if err != nil {
+		return nil, err
+	}
+	out, _, err := transform.Bytes(enc.NewEncoder(), data)
+	return out, err
+}
+
+// NewDetectReader 读取 r 的前 4KB 探测编码，返回转换为 UTF-8 的 Reader；无法识别时返回 ErrUnknownCharset
+func NewDetectReader(r io.Reader) (io.Reader, Charset, error) {
+	br := bufio.NewReaderSize(r, detectSize)
+	head, err := br.Peek(detectSize)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, Unknown, err
+	}
+	c := detect(head, err == nil)
+	if c == Unknown {
+		return nil, Unknown, ErrUnknownCharset
+	}
+	dr, err := NewDecoder(br, c)
+	return dr, c, err
+}
+
+// Detect 探测 data 的编码，依次尝试 UTF-8、GBK 与 BIG5、GB18030，均不合法时返回 Unknown；
+// 纯 ASCII 返回 UTF8。GBK 与 BIG5 的编码空间大量重叠，只能按字节分布推测，短文本可能误判
+func Detect(data []byte) Charset {
+	return detect(data, false)
+}
+
+// detect partial 为 true 时 data 只是前缀，忽略末尾被截断的字符
+func detect(data []byte, partial bool) Charset {
+	if bytes.HasPrefix(data, utf8BOM) {
+		return UTF8
+	}
+	if validUTF8(data, partial) {
+		return UTF8
+	}
+	gbk := validGBK(data, partial)
+	if gbk && !looksLikeBig5(data) {
+		return GBK
+	}
+	if validBig5(data, partial) {
+		return BIG5
+	}
+	if gbk {
+		return GBK
+	}
+	if validGB18030(data, partial) {
+		return GB18030
+	}
+	return Unknown
+}
+
+func validUTF8(data []byte, partial bool) bool {
+	if partial {
+		// 末尾最多 3 个字节属于被截断的字符
+		for i := len(data) - 1; i >= 0 && i >= len(data)-3; i-- {
+			if utf8.RuneStart(data[i]) {
+				if !utf8.FullRune(data[i:]) {
+					data = data[:i]
+				}
+				break
+			}
+		}
+	}
+	return utf8.Valid(data)
+}
+
+// IsGBK 是否为合法的 GBK 编码（包括纯 ASCII）
+func IsGBK(data []byte) bool {
+	return validGBK(data, false)
+}
+
+// IsGB18030 是否为合法的 GB18030 编码，GB18030 兼容 GBK
+func IsGB18030(data []byte) bool {
+	return validGB18030(data, false)
+}
+
+// IsBig5 是否为合法的 BIG5 编码
+func IsBig5(data []byte) bool {
+	return validBig5(data, false)
+}
+
+// validGBK 单字节 0x00~0x7F 兼容 ASCII；双字节首字节 0x81~0xFE，尾字节 0x40~0xFE 且不为 0x7F
+func validGBK(data []byte, partial bool) bool {
+	for i := 0; i < len(data); {
+		if data[i] <= 0x7f {
+			i++
+			continue
+		}
+		if data[i] < 0x81 || data[i] > 0xfe {
+			return false
+		}
+		if i+1 >= len(data) {
+			return partial
+		}
+		if t := data[i+1]; t < 0x40 || t > 0xfe || t == 0x7f {
+			return false
+		}
+		i += 2
+	}
+	return true
+}
+
+// validGB18030 在 GBK 基础上增加四字节编码：[0x81~0xFE][0x30~0x39][0x81~0xFE][0x30~0x39]；
+// 单字节 0x80 为欧元符号（x/text 的编码器按 CP936 输出）
+func validGB18030(data []byte, partial bool) bool {
+	for i := 0; i < len(data); {
+		if data[i] <= 0x80 {
+			i++
+			continue
+		}
+		if data[i] < 0x81 || data[i] > 0xfe {
+			return false
+		}
+		if i+1 >= len(data) {
+			return partial
+		}
+		if t := data[i+1]; t >= 0x30 && t <= 0x39 {
+			if i+3 >= len(data) {
+				return partial
+			}
+			if data[i+2] < 0x81 || data[i+2] > 0xfe || data[i+3] < 0x30 || data[i+3] > 0x39 {
+				return false
+			}
+			i += 4
+			continue
+		}
+		if t := data[i+1]; t < 0x40 || t > 0xfe || t == 0x7f {
+			return false
+		}
+		i += 2
+	}
+	return true
+}
+
+// validBig5 首字节 0x81~0xFE（含 HKSCS 扩展），尾字节 0x40~0x7E 或 0xA1~0xFE
+func validBig5(data []byte, partial bool) bool {
+	for i := 0; i < len(data); {
+		if data[i] <= 0x7f {
+			i++
+			continue
+		}
+		if data[i] < 0x81 || data[i] > 0xfe {
+			return false
+		}
+		if i+1 >= len(data) {
+			return partial
+		}
+		if t := data[i+1]; !(t >= 0x40 && t <= 0x7e) && !(t >= 0xa1 && t <= 0xfe) {
+			return false
+		}
+		i += 2
+	}
+	return true
+}
+
+// looksLikeBig5 常用简体汉字（GB2312 区）的尾字节都不小于 0xA1，
+// 而 BIG5 常用字约四成尾字节落在 0x40~0x7E；超过五分之一时按 BIG5 处理
+func looksLikeBig5(data []byte) bool {
+	var total, low int
+	for i := 0; i+1 < len(data); {
+		if data[i] <= 0x7f {
+			i++
+			continue
+		}
+		total++
+		if data[i+1] <= 0x7e {
+			low++
+		}
+		i += 2
+	}
+	return total > 0 && low*5 > total
+}
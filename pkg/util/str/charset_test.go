@@ -0,0 +1,103 @@
+package str
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestIsGBK(t *testing.T) {
+	gbk, _ := Encode([]byte("盲盒abc"), GBK)
+	cases := []struct {
+		data []byte
+		want bool
+	}{
+		{[]byte("ascii"), true},
+		{gbk, true},
+		{gbk[:len(gbk)-4], false}, // 末尾只剩首字节，不能越界读取
+		{[]byte{0x81, 0x7f}, false},
+		{[]byte{0x80, 0x40}, false},
+	}
+	for _, c := range cases {
+		if got := IsGBK(c.data); got != c.want {
+			t.Errorf("IsGBK(% x) = %v, want %v", c.data, got, c.want)
+		}
+	}
+}
+
+func TestDetect(t *testing.T) {
+	text := "合作方回调：订单已支付，金额一百元"
+	cases := []struct {
+		charset Charset
+		text    string
+	}{
+		{UTF8, text},
+		{GBK, text},
+		{GB18030, text + "€😀"},
+		{BIG5, "合作夥伴回調：訂單已經支付，請儘快處理"},
+	}
+	for _, c := range cases {
+		data, err := Encode([]byte(c.text), c.charset)
+		if err != nil {
+			t.Fatalf("Encode %s: %v", c.charset, err)
+		}
+		if got := Detect(data); got != c.charset {
+			t.Errorf("Detect(%s) = %q", c.charset, got)
+		}
+		out, err := Decode(data, c.charset)
+		if err != nil || string(out) != c.text {
+			t.Errorf("Decode(%s) = %q, %v", c.charset, out, err)
+		}
+	}
+	if got := Detect([]byte{0xff, 0xfe, 0x00}); got != Unknown {
+		t.Errorf("Detect(invalid) = %q", got)
+	}
+}
+
+func TestStream(t *testing.T) {
+	text := strings.Repeat("编号,名称\n1,盲盒\n", 1000)
+
+	var buf bytes.Buffer
+	w, err := NewEncoder(&buf, GB18030)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.WriteString(w, text); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// 超过探测长度，前缀末尾可能截断字符
+	r, c, err := NewDetectReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c != GBK {
+		t.Errorf("charset = %q, want gbk", c)
+	}
+	out, err := io.ReadAll(r)
+	if err != nil || string(out) != text {
+		t.Errorf("round trip mismatch: %v", err)
+	}
+}
+
+func TestCharsetFromContentType(t *testing.T) {
+	cases := map[string]Charset{
+		"application/json":                                Unknown,
+		"application/json; charset=GBK":                   GBK,
+		"text/csv; charset=\"gb2312\"":                    GBK,
+		"application/json; charset=utf-8":                 UTF8,
+		"application/x-www-form-urlencoded; charset=Big5": BIG5,
+	}
+	for ct, want := range cases {
+		if got, err := CharsetFromContentType(ct); err != nil || got != want {
+			t.Errorf("CharsetFromContentType(%q) = %q, %v", ct, got, err)
+		}
+	}
+	if _, err := CharsetFromContentType("text/plain; charset=latin1"); err != ErrUnknownCharset {
+		t.Errorf("unknown charset err = %v", err)
+	}
+}
@@ -24,31 +24,6 @@ func GetMD5Encode(data string) string {
 	return hex.EncodeToString(h.Sum(nil))
 }
 
-func IsGBK(data []byte) bool {
-	length := len(data)
-	var i int = 0
-	for i < length {
-		if data[i] <= 0x7f {
-			//编码0~127,只有一个字节的编码，兼容ASCII码
-			i++
-			continue
-		} else {
-			//大于127的使用双字节编码，落在gbk编码范围内的字符
-			if data[i] >= 0x81 &&
-				data[i] <= 0xfe &&
-				data[i+1] >= 0x40 &&
-				data[i+1] <= 0xfe &&
-				data[i+1] != 0xf7 {
-				i += 2
-				continue
-			} else {
-				return false
-			}
-		}
-	}
-	return true
-}
-
 func Int64ToStr(num int64) string {
 	return strconv.FormatInt(num, 10)
 }
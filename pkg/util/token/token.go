@@ -18,7 +18,7 @@ import (
 // Parse token
 var (
 	InvalidErr     error         = errors.New("Couldn't handle this token:")
-	SignKey        string        = "cassoWong"
+	SignKey        string        // 启动时通过 SetSignKey 设置，不在代码中写死
 	ExpiredTime    time.Duration = time.Minute * 60
 	AddExpiredTime time.Duration = time.Minute * 60
 )
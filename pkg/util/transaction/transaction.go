@@ -1,38 +1,214 @@
-package tool
+/*
+ * @PackageName: transaction
+ * @Description: 基于 context 传递的 [GORM] 事务（unit of work）
+ * 事务对象保存在 context 中，data 层的 repo 统一通过 Manager.DB(ctx) 获取连接，
+ * 在 InTx 内调用的任意 repo 方法都会自动加入当前事务，biz 层无需感知 gorm：
+ *   err := tm.InTx(ctx, func(ctx context.Context) error {
+ *       if err := userRepo.Create(ctx, u); err != nil { return err }
+ *       transaction.AfterCommit(ctx, func(ctx context.Context) { publish(ctx, event) })
+ *       return accountRepo.Open(ctx, u.ID)
+ *   })
+ * 嵌套调用 InTx 时使用 SAVEPOINT，内层失败只回滚到保存点
+ */
+package transaction
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
+	"strings"
 
 	"gorm.io/gorm"
 )
 
-type ExecFun func(db *gorm.DB) error
+type ctxKey struct{}
 
-// 简易全局 [GORM] 事务封装
-// 事务具有隔离性，如果一个事务内业务需要等待其他事务的结果数据，则最好合并在同一个事务中避免脏读/幻读
-// 以下方法适用于多个事务在数据上互不干涉，只需要保证同时成功或失败
-// 如果业务需要必须要依赖于其他事物正在进行的数据，则可以添加一个 *gorm.DB 入参，保证所有事务串行在同一个操作中
-func Tx(execs []ExecFun, gdb *gorm.DB) (err error) {
-	tx := gdb.Begin()
+// txState 当前事务状态，同一个根事务内的嵌套调用共享
+type txState struct {
+	db    *gorm.DB
+	depth int
+	hooks []func(ctx context.Context)
+}
+
+// TxOption 单次事务配置
+type TxOption func(*sql.TxOptions)
+
+// WithIsolation 指定事务隔离级别，嵌套事务沿用外层事务的隔离级别
+func WithIsolation(level sql.IsolationLevel) TxOption {
+	return func(o *sql.TxOptions) {
+		o.Isolation = level
+	}
+}
+
+// WithReadOnly 只读事务
+func WithReadOnly() TxOption {
+	return func(o *sql.TxOptions) {
+		o.ReadOnly = true
+	}
+}
+
+// Manager 事务管理器
+type Manager struct {
+	db        *gorm.DB
+	isolation sql.IsolationLevel
+}
+
+// Option 事务管理器配置
+type Option func(*Manager)
+
+// WithDefaultIsolation 默认隔离级别，不指定时使用数据库默认值
+func WithDefaultIsolation(level sql.IsolationLevel) Option {
+	return func(m *Manager) {
+		m.isolation = level
+	}
+}
+
+// NewManager 新建事务管理器
+func NewManager(db *gorm.DB, opts ...Option) *Manager {
+	m := &Manager{db: db}
+	for _, o := range opts {
+		o(m)
+	}
+	return m
+}
+
+// DB 返回 context 中的事务连接，不在事务中时返回普通连接
+func (m *Manager) DB(ctx context.Context) *gorm.DB {
+	if tx, ok := FromContext(ctx); ok {
+		return tx
+	}
+	return m.db.WithContext(ctx)
+}
+
+// InTx 在事务中执行 fn；fn 返回错误或 panic 时回滚，成功提交后依次执行 AfterCommit 注册的回调
+func (m *Manager) InTx(ctx context.Context, fn func(ctx context.Context) error, opts ...TxOption) (err error) {
+	if st, ok := ctx.Value(ctxKey{}).(*txState); ok {
+		return m.nested(ctx, st, fn)
+	}
+
+	txOpts := &sql.TxOptions{Isolation: m.isolation}
+	for _, o := range opts {
+		o(txOpts)
+	}
+	tx := m.db.WithContext(ctx).Begin(txOpts)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	st := &txState{db: tx}
 	defer func() {
 		if r := recover(); r != nil {
 			tx.Rollback()
-			err = fmt.Errorf("%v", err)
+			panic(r)
 		}
 	}()
 
-	for _, f := range execs {
-		err = f(tx)
-		if err != nil {
-			tx.Rollback()
-			return
+	if err = fn(context.WithValue(ctx, ctxKey{}, st)); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit().Error; err != nil {
+		return err
+	}
+
+	// 回调使用原始 context，避免继续使用已提交的事务
+	for _, hook := range st.hooks {
+		hook(ctx)
+	}
+	return nil
+}
+
+// nested 嵌套事务使用保存点，失败时只回滚内层的修改与回调
+func (m *Manager) nested(ctx context.Context, st *txState, fn func(ctx context.Context) error) (err error) {
+	st.depth++
+	name := fmt.Sprintf("sp_%d", st.depth)
+	hooks := len(st.hooks)
+	defer func() {
+		st.depth--
+	}()
+
+	if err = st.db.SavePoint(name).Error; err != nil {
+		return err
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			st.db.RollbackTo(name)
+			st.hooks = st.hooks[:hooks]
+			panic(r)
+		}
+	}()
+
+	if err = fn(ctx); err != nil {
+		if rerr := st.db.RollbackTo(name).Error; rerr != nil {
+			return fmt.Errorf("%v (rollback to savepoint: %v)", err, rerr)
 		}
+		st.hooks = st.hooks[:hooks]
+		return err
 	}
+	return nil
+}
 
-	return tx.Commit().Error
+// FromContext 获取 context 中的事务连接
+func FromContext(ctx context.Context) (*gorm.DB, bool) {
+	if st, ok := ctx.Value(ctxKey{}).(*txState); ok {
+		return st.db, true
+	}
+	return nil, false
+}
+
+// AfterCommit 注册事务提交后的回调（例如发布领域事件），事务回滚时不会执行；
+// 不在事务中时立即执行
+func AfterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	if st, ok := ctx.Value(ctxKey{}).(*txState); ok {
+		st.hooks = append(st.hooks, fn)
+		return
+	}
+	fn(ctx)
+}
+
+// ParseIsolation 解析配置中的隔离级别，例如 "READ COMMITTED"、"repeatable_read"
+func ParseIsolation(s string) (sql.IsolationLevel, error) {
+	switch strings.ToUpper(strings.NewReplacer("_", " ", "-", " ").Replace(strings.TrimSpace(s))) {
+	case "", "DEFAULT":
+		return sql.LevelDefault, nil
+	case "READ UNCOMMITTED":
+		return sql.LevelReadUncommitted, nil
+	case "READ COMMITTED":
+		return sql.LevelReadCommitted, nil
+	case "REPEATABLE READ":
+		return sql.LevelRepeatableRead, nil
+	case "SERIALIZABLE":
+		return sql.LevelSerializable, nil
+	default:
+		return sql.LevelDefault, fmt.Errorf("transaction: unknown isolation level %q", s)
+	}
+}
+
+type ExecFun func(db *gorm.DB) error
+
+// Tx 简易全局 [GORM] 事务封装，执行过程中 panic 会回滚并以错误返回
+//
+// Deprecated: 使用 Manager.InTx，repo 方法通过 context 自动加入事务
+func Tx(execs []ExecFun, gdb *gorm.DB) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("transaction panic: %v", r)
+		}
+	}()
+	return NewManager(gdb).InTx(context.Background(), func(ctx context.Context) error {
+		tx, _ := FromContext(ctx)
+		for _, f := range execs {
+			if err := f(tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 // NewExec 生成新的事务执行方法 暂时只支持sql语句
+//
+// Deprecated: 使用 Manager.InTx
 func NewExec(args string) ExecFun {
 	return func(db *gorm.DB) error {
 		return db.Exec(args).Error
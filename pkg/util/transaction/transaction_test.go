@@ -0,0 +1,258 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+func TestParseIsolation(t *testing.T) {
+	cases := map[string]sql.IsolationLevel{
+		"":                sql.LevelDefault,
+		"READ COMMITTED":  sql.LevelReadCommitted,
+		"repeatable_read": sql.LevelRepeatableRead,
+		" serializable ":  sql.LevelSerializable,
+	}
+	for in, want := range cases {
+		got, err := ParseIsolation(in)
+		if err != nil || got != want {
+			t.Fatalf("ParseIsolation(%q) = %v, %v; want %v", in, got, err, want)
+		}
+	}
+	if _, err := ParseIsolation("snapshot"); err == nil {
+		t.Fatal("expected error for unknown level")
+	}
+}
+
+func TestAfterCommitOutsideTx(t *testing.T) {
+	called := false
+	AfterCommit(context.Background(), func(ctx context.Context) { called = true })
+	if !called {
+		t.Fatal("hook should run immediately outside a transaction")
+	}
+	if _, ok := FromContext(context.Background()); ok {
+		t.Fatal("unexpected transaction in context")
+	}
+}
+
+// recorder 记录驱动收到的事务语句，dsn 区分不同的测试
+type recorder struct {
+	mu  sync.Mutex
+	log []string
+}
+
+func (r *recorder) add(s string) {
+	r.mu.Lock()
+	r.log = append(r.log, s)
+	r.mu.Unlock()
+}
+
+func (r *recorder) statements() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.log...)
+}
+
+type fakeDriver struct {
+	mu        sync.Mutex
+	recorders map[string]*recorder
+}
+
+func (d *fakeDriver) Open(dsn string) (driver.Conn, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return &fakeConn{r: d.recorders[dsn]}, nil
+}
+
+type fakeConn struct{ r *recorder }
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not implemented") }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *fakeConn) BeginTx(_ context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	begin := "BEGIN"
+	if lvl := sql.IsolationLevel(opts.Isolation); lvl != sql.LevelDefault {
+		begin += " " + lvl.String()
+	}
+	if opts.ReadOnly {
+		begin += " READ ONLY"
+	}
+	c.r.add(begin)
+	return fakeTx{c.r}, nil
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	c.r.add(query)
+	return driver.RowsAffected(1), nil
+}
+
+type fakeTx struct{ r *recorder }
+
+func (tx fakeTx) Commit() error   { tx.r.add("COMMIT"); return nil }
+func (tx fakeTx) Rollback() error { tx.r.add("ROLLBACK"); return nil }
+
+var fake = &fakeDriver{recorders: map[string]*recorder{}}
+
+func init() {
+	sql.Register("transaction-fake", fake)
+}
+
+func newManager(t *testing.T, opts ...Option) (*Manager, *recorder) {
+	r := &recorder{}
+	fake.mu.Lock()
+	fake.recorders[t.Name()] = r
+	fake.mu.Unlock()
+	sqlDB, err := sql.Open("transaction-fake", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewManager(db, opts...), r
+}
+
+func exec(ctx context.Context, m *Manager, query string) error {
+	return m.DB(ctx).Exec(query).Error
+}
+
+func TestInTxCommit(t *testing.T) {
+	m, r := newManager(t)
+	var atCommit []string
+	err := m.InTx(context.Background(), func(ctx context.Context) error {
+		AfterCommit(ctx, func(context.Context) { atCommit = r.statements() })
+		return exec(ctx, m, "INSERT a")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"BEGIN", "INSERT a", "COMMIT"}
+	if got := r.statements(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("statements = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(atCommit, want) {
+		t.Fatalf("hook should run after commit, saw %v", atCommit)
+	}
+}
+
+func TestInTxRollback(t *testing.T) {
+	m, r := newManager(t)
+	called := false
+	boom := errors.New("boom")
+	err := m.InTx(context.Background(), func(ctx context.Context) error {
+		AfterCommit(ctx, func(context.Context) { called = true })
+		if err := exec(ctx, m, "INSERT a"); err != nil {
+			return err
+		}
+		return boom
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("err = %v", err)
+	}
+	if got, want := r.statements(), []string{"BEGIN", "INSERT a", "ROLLBACK"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("statements = %v, want %v", got, want)
+	}
+	if called {
+		t.Fatal("hook should be dropped on rollback")
+	}
+}
+
+func TestInTxPanic(t *testing.T) {
+	m, r := newManager(t)
+	called := false
+	func() {
+		defer func() {
+			if p := recover(); p != "boom" {
+				t.Fatalf("recovered %v, want re-panic", p)
+			}
+		}()
+		_ = m.InTx(context.Background(), func(ctx context.Context) error {
+			AfterCommit(ctx, func(context.Context) { called = true })
+			_ = exec(ctx, m, "INSERT a")
+			panic("boom")
+		})
+	}()
+	if got, want := r.statements(), []string{"BEGIN", "INSERT a", "ROLLBACK"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("statements = %v, want %v", got, want)
+	}
+	if called {
+		t.Fatal("hook should be dropped on panic")
+	}
+}
+
+func TestNestedInTx(t *testing.T) {
+	m, r := newManager(t)
+	var hooks []string
+	hook := func(name string) func(context.Context) {
+		return func(context.Context) { hooks = append(hooks, fmt.Sprintf("%s after %d", name, len(r.statements()))) }
+	}
+	err := m.InTx(context.Background(), func(ctx context.Context) error {
+		if err := exec(ctx, m, "INSERT a"); err != nil {
+			return err
+		}
+		// 内层失败只回滚到保存点，注册的回调一起丢弃
+		inner := m.InTx(ctx, func(ctx context.Context) error {
+			AfterCommit(ctx, hook("failed"))
+			_ = exec(ctx, m, "INSERT b")
+			return errors.New("inner")
+		})
+		if inner == nil {
+			t.Error("inner error should be returned")
+		}
+		// 内层成功的回调等到最外层提交后执行
+		if err := m.InTx(ctx, func(ctx context.Context) error {
+			AfterCommit(ctx, hook("nested"))
+			return exec(ctx, m, "INSERT c")
+		}); err != nil {
+			return err
+		}
+		AfterCommit(ctx, hook("outer"))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"BEGIN",
+		"INSERT a",
+		"SAVEPOINT sp_1", "INSERT b", "ROLLBACK TO SAVEPOINT sp_1",
+		"SAVEPOINT sp_1", "INSERT c",
+		"COMMIT",
+	}
+	if got := r.statements(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("statements = %v, want %v", got, want)
+	}
+	if want := []string{"nested after 8", "outer after 8"}; !reflect.DeepEqual(hooks, want) {
+		t.Fatalf("hooks = %v, want %v", hooks, want)
+	}
+}
+
+func TestIsolation(t *testing.T) {
+	m, r := newManager(t, WithDefaultIsolation(sql.LevelReadCommitted))
+	ctx := context.Background()
+	noop := func(context.Context) error { return nil }
+	if err := m.InTx(ctx, noop); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.InTx(ctx, noop, WithIsolation(sql.LevelSerializable), WithReadOnly()); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"BEGIN Read Committed", "COMMIT", "BEGIN Serializable READ ONLY", "COMMIT"}
+	if got := r.statements(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("statements = %v, want %v", got, want)
+	}
+}